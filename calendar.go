@@ -0,0 +1,172 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import "time"
+
+// A Calendar determines which dates are business days.
+// Saturdays, Sundays, and holidays are not business days.
+// The zero value is a calendar with no holidays.
+type Calendar struct {
+	// Holidays is the set of dates that are not business days.
+	Holidays map[Date]bool
+}
+
+// USFederalCalendar returns a calendar with the United States federal holidays
+// observed in the given year.
+// A holiday that falls on a Saturday is observed on the preceding Friday,
+// and one that falls on a Sunday is observed on the following Monday.
+// Holidays contains the observed dates,
+// so when New Year's Day of the following year falls on a Saturday,
+// the calendar includes December 31 of the given year.
+// Martin Luther King Jr. Day is included from 1986
+// and Juneteenth from 2021.
+func USFederalCalendar(year int) Calendar {
+	holidays := make(map[Date]bool)
+	add := func(d Date) {
+		if d2 := observedDate(d); d2.Year() == year {
+			holidays[d2] = true
+		}
+	}
+	nth := func(month time.Month, w time.Weekday, n int) Date {
+		d, _ := NthWeekdayInMonth(year, month, w, n)
+		return d
+	}
+
+	add(NewDate(year, time.January, 1))
+	add(NewDate(year+1, time.January, 1))
+	if year >= 1986 {
+		add(nth(time.January, time.Monday, 3))
+	}
+	add(nth(time.February, time.Monday, 3))
+	add(nth(time.May, time.Monday, -1))
+	if year >= 2021 {
+		add(NewDate(year, time.June, 19))
+	}
+	add(NewDate(year, time.July, 4))
+	add(nth(time.September, time.Monday, 1))
+	add(nth(time.October, time.Monday, 2))
+	add(NewDate(year, time.November, 11))
+	add(nth(time.November, time.Thursday, 4))
+	add(NewDate(year, time.December, 25))
+	return Calendar{Holidays: holidays}
+}
+
+// observedDate returns the weekday on which a holiday falling on d is observed.
+func observedDate(d Date) Date {
+	switch d.Weekday() {
+	case time.Saturday:
+		return d.AddDays(-1)
+	case time.Sunday:
+		return d.AddDays(1)
+	default:
+		return d
+	}
+}
+
+// IsBusinessDay reports whether d is neither a weekend day nor a holiday.
+func (c Calendar) IsBusinessDay(d Date) bool {
+	switch d.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return !c.Holidays[d]
+}
+
+// nextBusinessDay returns the first business day on or after d.
+func (c Calendar) nextBusinessDay(d Date) Date {
+	n := d.absDays()
+	for !c.IsBusinessDay(d) {
+		n++
+		d = dateFromAbsDays(n)
+	}
+	return d
+}
+
+// AddAtLeastBusinessDays returns the first business day
+// that is at least minDays calendar days after d.
+// This is useful for estimates like "ships in 3 days or more".
+func (c Calendar) AddAtLeastBusinessDays(d Date, minDays int) Date {
+	return c.nextBusinessDay(dateFromAbsDays(d.absDays() + minDays))
+}
+
+// Deadline returns the date that is businessDays business days after start,
+// answering "resolve within N business days of start".
+// start itself is never counted, even if it is a business day,
+// so a ticket opened on a Friday with a one business day deadline
+// is due the following Monday.
+// A businessDays of zero returns the first business day on or after start.
+// A negative businessDays counts backward,
+// so it returns the date that is -businessDays business days before start.
+func (c Calendar) Deadline(start Date, businessDays int) Date {
+	if businessDays == 0 {
+		return c.nextBusinessDay(start)
+	}
+	return AdvanceSkipping(start, businessDays, func(d Date) bool {
+		return !c.IsBusinessDay(d)
+	})
+}
+
+// AdvanceSkipping returns the date reached by moving n days from d,
+// counting only days for which skip returns false.
+// d itself is never counted.
+// If n is negative, AdvanceSkipping moves backward.
+// If n is zero, AdvanceSkipping returns d.
+// AdvanceSkipping does not return if skip never returns false.
+func AdvanceSkipping(d Date, n int, skip func(Date) bool) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	days := d.absDays()
+	for n > 0 {
+		days += step
+		if !skip(dateFromAbsDays(days)) {
+			n--
+		}
+	}
+	return dateFromAbsDays(days)
+}
+
+// NextBusinessDayInRange returns the first business day on or after d
+// that is within r. It returns false if there is no such day.
+func (c Calendar) NextBusinessDayInRange(d Date, r DateRange) (Date, bool) {
+	n, end := d.absDays(), r.End.absDays()
+	if start := r.Start.absDays(); n < start {
+		n = start
+	}
+	for ; n <= end; n++ {
+		if d := dateFromAbsDays(n); c.IsBusinessDay(d) {
+			return d, true
+		}
+	}
+	return Date{}, false
+}
+
+// WorkdaysRemainingInMonth returns the number of business days
+// from d through the end of d's month, including d itself.
+func (c Calendar) WorkdaysRemainingInMonth(d Date) int {
+	n := 0
+	start := d.absDays()
+	end := start + DaysInMonth(d.Year(), d.Month()) - d.Day()
+	for i := start; i <= end; i++ {
+		if c.IsBusinessDay(dateFromAbsDays(i)) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,269 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsBusinessDay(t *testing.T) {
+	c := Calendar{Holidays: map[Date]bool{
+		NewDate(2019, time.July, 4): true,
+	}}
+	tests := []struct {
+		d    Date
+		want bool
+	}{
+		{d: NewDate(2019, time.February, 6), want: true},
+		{d: NewDate(2019, time.February, 9), want: false},
+		{d: NewDate(2019, time.February, 10), want: false},
+		{d: NewDate(2019, time.July, 4), want: false},
+		{d: NewDate(2019, time.July, 5), want: true},
+	}
+	for _, test := range tests {
+		if got := c.IsBusinessDay(test.d); got != test.want {
+			t.Errorf("IsBusinessDay(%v) = %t; want %t", test.d, got, test.want)
+		}
+	}
+}
+
+func TestUSFederalCalendar(t *testing.T) {
+	c2019 := USFederalCalendar(2019)
+	want2019 := []Date{
+		NewDate(2019, time.January, 1),
+		NewDate(2019, time.January, 21),
+		NewDate(2019, time.February, 18),
+		NewDate(2019, time.May, 27),
+		NewDate(2019, time.July, 4),
+		NewDate(2019, time.September, 2),
+		NewDate(2019, time.October, 14),
+		NewDate(2019, time.November, 11),
+		NewDate(2019, time.November, 28),
+		NewDate(2019, time.December, 25),
+	}
+	if len(c2019.Holidays) != len(want2019) {
+		t.Errorf("len(USFederalCalendar(2019).Holidays) = %d; want %d", len(c2019.Holidays), len(want2019))
+	}
+	for _, d := range want2019 {
+		if c2019.IsBusinessDay(d) {
+			t.Errorf("USFederalCalendar(2019).IsBusinessDay(%v) = true; want false", d)
+		}
+	}
+
+	tests := []struct {
+		year int
+		d    Date
+		want bool
+	}{
+		// A normal weekday.
+		{year: 2019, d: NewDate(2019, time.February, 6), want: true},
+		// Juneteenth is a holiday starting in 2021.
+		{year: 2019, d: NewDate(2019, time.June, 19), want: true},
+		{year: 2023, d: NewDate(2023, time.June, 19), want: false},
+		// July 4, 2020 was a Saturday, observed Friday, July 3.
+		{year: 2020, d: NewDate(2020, time.July, 3), want: false},
+		{year: 2020, d: NewDate(2020, time.July, 6), want: true},
+		// Christmas 2022 was a Sunday, observed Monday, December 26.
+		{year: 2022, d: NewDate(2022, time.December, 26), want: false},
+		{year: 2022, d: NewDate(2022, time.December, 23), want: true},
+		// New Year's Day 2022 was a Saturday, observed Friday, December 31, 2021.
+		{year: 2021, d: NewDate(2021, time.December, 31), want: false},
+		{year: 2022, d: NewDate(2021, time.December, 31), want: true},
+		// Martin Luther King Jr. Day is a holiday starting in 1986.
+		{year: 1985, d: NewDate(1985, time.January, 21), want: true},
+		{year: 1986, d: NewDate(1986, time.January, 20), want: false},
+	}
+	for _, test := range tests {
+		if got := USFederalCalendar(test.year).IsBusinessDay(test.d); got != test.want {
+			t.Errorf("USFederalCalendar(%d).IsBusinessDay(%v) = %t; want %t", test.year, test.d, got, test.want)
+		}
+	}
+}
+
+func TestAddAtLeastBusinessDays(t *testing.T) {
+	c := Calendar{Holidays: map[Date]bool{
+		NewDate(2019, time.July, 4): true,
+	}}
+	tests := []struct {
+		d       Date
+		minDays int
+		want    Date
+	}{
+		// Wednesday + 2 days = Friday.
+		{d: NewDate(2019, time.February, 6), minDays: 2, want: NewDate(2019, time.February, 8)},
+		// Wednesday + 3 days = Saturday, rolls to Monday.
+		{d: NewDate(2019, time.February, 6), minDays: 3, want: NewDate(2019, time.February, 11)},
+		// Wednesday + 4 days = Sunday, rolls to Monday.
+		{d: NewDate(2019, time.February, 6), minDays: 4, want: NewDate(2019, time.February, 11)},
+		{d: NewDate(2019, time.February, 6), minDays: 0, want: NewDate(2019, time.February, 6)},
+		// Saturday + 0 days rolls to Monday.
+		{d: NewDate(2019, time.February, 9), minDays: 0, want: NewDate(2019, time.February, 11)},
+		// Monday + 3 days = Thursday holiday, rolls to Friday.
+		{d: NewDate(2019, time.July, 1), minDays: 3, want: NewDate(2019, time.July, 5)},
+	}
+	for _, test := range tests {
+		if got := c.AddAtLeastBusinessDays(test.d, test.minDays); got != test.want {
+			t.Errorf("AddAtLeastBusinessDays(%v, %d) = %v; want %v", test.d, test.minDays, got, test.want)
+		}
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	c := Calendar{Holidays: map[Date]bool{
+		NewDate(2019, time.July, 4): true,
+		NewDate(2019, time.July, 5): true,
+	}}
+	tests := []struct {
+		start        Date
+		businessDays int
+		want         Date
+	}{
+		// Wednesday + 1 business day = Thursday.
+		{start: NewDate(2019, time.February, 6), businessDays: 1, want: NewDate(2019, time.February, 7)},
+		// Wednesday + 3 business days crosses the weekend to Monday.
+		{start: NewDate(2019, time.February, 6), businessDays: 3, want: NewDate(2019, time.February, 11)},
+		// Friday + 1 business day = Monday.
+		{start: NewDate(2019, time.February, 8), businessDays: 1, want: NewDate(2019, time.February, 11)},
+		// Saturday + 1 business day = Monday.
+		{start: NewDate(2019, time.February, 9), businessDays: 1, want: NewDate(2019, time.February, 11)},
+		// Wednesday + 5 business days = next Wednesday.
+		{start: NewDate(2019, time.February, 6), businessDays: 5, want: NewDate(2019, time.February, 13)},
+		// Wednesday + 1 business day skips Thursday and Friday holidays and the weekend.
+		{start: NewDate(2019, time.July, 3), businessDays: 1, want: NewDate(2019, time.July, 8)},
+		// Starting on a holiday.
+		{start: NewDate(2019, time.July, 4), businessDays: 2, want: NewDate(2019, time.July, 9)},
+		{start: NewDate(2019, time.February, 6), businessDays: 0, want: NewDate(2019, time.February, 6)},
+		{start: NewDate(2019, time.February, 9), businessDays: 0, want: NewDate(2019, time.February, 11)},
+		// Wednesday - 1 business day = Tuesday.
+		{start: NewDate(2019, time.February, 6), businessDays: -1, want: NewDate(2019, time.February, 5)},
+		// Monday - 1 business day = Friday.
+		{start: NewDate(2019, time.February, 11), businessDays: -1, want: NewDate(2019, time.February, 8)},
+		// Monday - 1 business day skips the weekend and Thursday and Friday holidays.
+		{start: NewDate(2019, time.July, 8), businessDays: -1, want: NewDate(2019, time.July, 3)},
+	}
+	for _, test := range tests {
+		if got := c.Deadline(test.start, test.businessDays); got != test.want {
+			t.Errorf("Deadline(%v, %d) = %v; want %v", test.start, test.businessDays, got, test.want)
+		}
+	}
+}
+
+func TestAdvanceSkipping(t *testing.T) {
+	// Skip every day whose Unix epoch day number is divisible by 3.
+	// 2019-02-06 is epoch day 17933, so 2019-02-07 (17934) is skipped.
+	skipThirds := func(d Date) bool {
+		return (d.absDays()-unixEpochAbsDays)%3 == 0
+	}
+	tests := []struct {
+		d    Date
+		n    int
+		want Date
+	}{
+		{d: NewDate(2019, time.February, 6), n: 0, want: NewDate(2019, time.February, 6)},
+		{d: NewDate(2019, time.February, 6), n: 1, want: NewDate(2019, time.February, 8)},
+		{d: NewDate(2019, time.February, 6), n: 2, want: NewDate(2019, time.February, 9)},
+		{d: NewDate(2019, time.February, 6), n: 3, want: NewDate(2019, time.February, 11)},
+		{d: NewDate(2019, time.February, 6), n: -1, want: NewDate(2019, time.February, 5)},
+		{d: NewDate(2019, time.February, 6), n: -2, want: NewDate(2019, time.February, 3)},
+		// Starting on a skipped day.
+		{d: NewDate(2019, time.February, 7), n: 1, want: NewDate(2019, time.February, 8)},
+	}
+	for _, test := range tests {
+		if got := AdvanceSkipping(test.d, test.n, skipThirds); got != test.want {
+			t.Errorf("AdvanceSkipping(%v, %d, skipThirds) = %v; want %v", test.d, test.n, got, test.want)
+		}
+	}
+
+	// Skipping weekends matches business days in a calendar without holidays.
+	isWeekend := func(d Date) bool {
+		w := d.Weekday()
+		return w == time.Saturday || w == time.Sunday
+	}
+	var c Calendar
+	for start := NewDate(2019, time.February, 1); start.Before(NewDate(2019, time.February, 15)); start = start.AddDays(1) {
+		for n := 1; n <= 10; n++ {
+			want := start
+			for i := 0; i < n; {
+				want = want.AddDays(1)
+				if c.IsBusinessDay(want) {
+					i++
+				}
+			}
+			if got := AdvanceSkipping(start, n, isWeekend); got != want {
+				t.Errorf("AdvanceSkipping(%v, %d, isWeekend) = %v; want %v", start, n, got, want)
+			}
+		}
+	}
+}
+
+func TestNextBusinessDayInRange(t *testing.T) {
+	c := Calendar{Holidays: map[Date]bool{
+		NewDate(2019, time.February, 11): true,
+	}}
+	feb := DateRange{Start: NewDate(2019, time.February, 1), End: NewDate(2019, time.February, 28)}
+	weekend := DateRange{Start: NewDate(2019, time.February, 9), End: NewDate(2019, time.February, 10)}
+	tests := []struct {
+		d      Date
+		r      DateRange
+		want   Date
+		wantOK bool
+	}{
+		{d: NewDate(2019, time.January, 15), r: feb, want: NewDate(2019, time.February, 1), wantOK: true},
+		{d: NewDate(2019, time.February, 6), r: feb, want: NewDate(2019, time.February, 6), wantOK: true},
+		// Saturday, then Monday holiday.
+		{d: NewDate(2019, time.February, 9), r: feb, want: NewDate(2019, time.February, 12), wantOK: true},
+		{d: NewDate(2019, time.February, 23), r: feb, want: NewDate(2019, time.February, 25), wantOK: true},
+		{d: NewDate(2019, time.March, 1), r: feb, wantOK: false},
+		{d: NewDate(2019, time.February, 1), r: weekend, wantOK: false},
+		{d: NewDate(2019, time.February, 9), r: weekend, wantOK: false},
+	}
+	for _, test := range tests {
+		got, ok := c.NextBusinessDayInRange(test.d, test.r)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("NextBusinessDayInRange(%v, %v) = %v, %t; want %v, %t", test.d, test.r, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func TestWorkdaysRemainingInMonth(t *testing.T) {
+	c := Calendar{Holidays: map[Date]bool{
+		NewDate(2019, time.February, 18): true,
+	}}
+	tests := []struct {
+		d    Date
+		want int
+	}{
+		// February 2019 has 20 weekdays, one of which is a holiday.
+		{d: NewDate(2019, time.February, 1), want: 19},
+		{d: NewDate(2019, time.February, 6), want: 16},
+		{d: NewDate(2019, time.February, 19), want: 8},
+		// Last business day of the month.
+		{d: NewDate(2019, time.February, 28), want: 1},
+		// August 2019 ends on a Saturday.
+		{d: NewDate(2019, time.August, 30), want: 1},
+		{d: NewDate(2019, time.August, 31), want: 0},
+		// March 2019 ends on a Sunday.
+		{d: NewDate(2019, time.March, 29), want: 1},
+		{d: NewDate(2019, time.March, 30), want: 0},
+	}
+	for _, test := range tests {
+		if got := c.WorkdaysRemainingInMonth(test.d); got != test.want {
+			t.Errorf("WorkdaysRemainingInMonth(%v) = %d; want %d", test.d, got, test.want)
+		}
+	}
+}
@@ -17,14 +17,28 @@
 package gregorian
 
 import (
+	"bytes"
+	"cmp"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-// A Date is a Gregorian date. The zero value is January 1, year 1.
+// A Date is a date in the proleptic Gregorian calendar.
+// The zero value is January 1, year 1.
+// Years before 1 use astronomical year numbering,
+// so year 0 is 1 BC and year -1 is 2 BC.
+//
+// Conversions between Date and [time.Time]
+// ([NewDate], [Date.ToTime], and [DateFromTime]) preserve the date exactly
+// for years -10000 through 10000.
 type Date struct {
 	year  int
 	month int
@@ -38,23 +52,272 @@ func NewDate(year int, month time.Month, day int) Date {
 	return Date{year: d.Year() - 1, month: int(d.Month() - 1), day: d.Day() - 1}
 }
 
-// ParseDate parses a date in either ISO 8601 format (2006-01-02) or U.S. format (1/2/2006).
+// DateFromTime returns the date on which t occurs in t's location.
+// The same instant may fall on different dates in different locations,
+// so callers should use [time.Time.In] to set the location first.
+func DateFromTime(t time.Time) Date {
+	year, month, day := t.Date()
+	return NewDate(year, month, day)
+}
+
+// ParseDate parses a date in ISO 8601 format (2006-01-02 or 2006/01/02),
+// including years with a sign or more than four digits (-0753-04-21),
+// ISO 8601 ordinal format (2006-002), U.S. format (1/2/2006 or 1-2-2006),
+// or dotted format, either year first (2006.01.02) or day first (2.1.2006).
 func ParseDate(s string) (Date, error) {
+	return parseDate(s, false)
+}
+
+// ParseDatePrefix parses a date in one of the numeric formats
+// accepted by [ParseDate] at the beginning of s
+// and returns the remainder of s after the date.
+// The date extends through the last digit in the leading run
+// of digits, '-', '/', and '.' characters,
+// so "2019-02-06. Next" returns a rest of ". Next".
+func ParseDatePrefix(s string) (_ Date, rest string, _ error) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isDigit(c) {
+			n = i + 1
+		} else if c != '-' && c != '/' && c != '.' {
+			break
+		}
+	}
+	if n == 0 {
+		return Date{}, s, fmt.Errorf("parse date prefix %q: no leading date", s)
+	}
+	d, err := parseDate(s[:n], false)
+	if err != nil {
+		return Date{}, s, err
+	}
+	return d, s[n:], nil
+}
+
+// ParseDatePivot parses a date like [ParseDate],
+// but also accepts a two-digit year in U.S. format (1/2/06)
+// or day-first dotted format (2.1.06).
+// A two-digit year is interpreted as the latest year
+// no later than pivot with the same last two digits,
+// so a pivot of 2069 maps "70" to 1970 and "69" to 2069.
+func ParseDatePivot(s string, pivot int) (Date, error) {
+	s = strings.TrimSpace(s)
+	for _, sep := range []string{"/", "."} {
+		parts := strings.Split(s, sep)
+		if len(parts) != 3 || len(parts[0]) > 2 || len(parts[2]) != 2 || !isDigits(parts[2]) {
+			continue
+		}
+		yy, _ := strconv.Atoi(parts[2])
+		parts[2] = strconv.Itoa(pivot - floorMod(pivot-yy, 100))
+		return ParseDate(strings.Join(parts, sep))
+	}
+	return ParseDate(s)
+}
+
+// parseDate implements [ParseDate].
+// If validateDay is true, then days beyond the end of the month are rejected
+// instead of being normalized into the following month.
+func parseDate(s string, validateDay bool) (Date, error) {
 	s = strings.TrimSpace(s)
+	var d Date
+	var err error
 	switch {
 	case s == "":
 		return Date{}, errors.New("empty date")
 	case strings.Contains(s, "/"):
-		return parseUSDate(s)
+		d, err = parseUSDate(s, validateDay)
 	case strings.Contains(s, "-"):
-		return parseISODate(s)
+		d, err = parseDashedDate(s, validateDay)
+	case strings.Contains(s, "."):
+		d, err = parseDottedDate(s, validateDay)
+	default:
+		err = fmt.Errorf("parse date %q: unknown format", s)
+	}
+	if err != nil {
+		if parse := customParser(s); parse != nil {
+			return parse(s)
+		}
+		return Date{}, err
+	}
+	return d, nil
+}
+
+// Strictness is the level of validation applied by [ParseDateStrict].
+type Strictness int
+
+const (
+	// Lenient accepts the same inputs as [ParseDate].
+	// Days up to 31 are accepted in any month
+	// and normalized into the following month, so "2019-02-30" is March 2.
+	Lenient Strictness = iota
+	// ValidDay accepts the same formats as [ParseDate],
+	// but rejects days that do not exist in the month.
+	ValidDay
+	// Strict accepts only the RFC 3339 full-date format:
+	// a zero-padded, dash-separated, four-digit year, month, and day
+	// that names a day that exists.
+	Strict
+)
+
+// ParseDateStrict parses a date with the given level of validation.
+func ParseDateStrict(s string, level Strictness) (Date, error) {
+	switch level {
+	case Lenient:
+		return parseDate(s, false)
+	case ValidDay:
+		return parseDate(s, true)
+	case Strict:
+		if !isFullDate(s) {
+			return Date{}, fmt.Errorf("parse date %q: not in YYYY-MM-DD format", s)
+		}
+		return parseISODate(s, true)
 	default:
-		return Date{}, fmt.Errorf("parse date %q: unknown format", s)
+		return Date{}, fmt.Errorf("parse date %q: unknown strictness %d", s, int(level))
+	}
+}
+
+// isFullDate reports whether s has the shape of an RFC 3339 full-date.
+func isFullDate(s string) bool {
+	return len(s) == len("2006-01-02") &&
+		isDigits(s[:4]) && s[4] == '-' &&
+		isDigits(s[5:7]) && s[7] == '-' &&
+		isDigits(s[8:])
+}
+
+var customParsers struct {
+	mu   sync.RWMutex
+	list []registeredParser
+}
+
+type registeredParser struct {
+	detect func(string) bool
+	parse  func(string) (Date, error)
+}
+
+// RegisterParser adds a date format to [ParseDate].
+// ParseDate calls parse on input that the built-in formats fail to parse
+// and for which detect returns true.
+// Input that a built-in format parses successfully never reaches parse.
+// Parsers are consulted in the order they were registered.
+// RegisterParser is safe to call from multiple goroutines.
+func RegisterParser(detect func(string) bool, parse func(string) (Date, error)) {
+	if detect == nil || parse == nil {
+		panic("gregorian.RegisterParser: nil function")
+	}
+	customParsers.mu.Lock()
+	defer customParsers.mu.Unlock()
+	customParsers.list = append(customParsers.list, registeredParser{detect, parse})
+}
+
+// customParser returns the first registered parse function
+// whose detect function matches s or nil if none match.
+func customParser(s string) func(string) (Date, error) {
+	customParsers.mu.RLock()
+	defer customParsers.mu.RUnlock()
+	for _, p := range customParsers.list {
+		if p.detect(s) {
+			return p.parse
+		}
+	}
+	return nil
+}
+
+// Canonicalize parses a date in any of the formats accepted by [ParseDate]
+// and returns it in ISO 8601 format, like "2006-01-02".
+func Canonicalize(s string) (string, error) {
+	d, err := ParseDate(s)
+	if err != nil {
+		return "", err
+	}
+	return d.String(), nil
+}
+
+// ParseDateLayout parses a date formatted according to layout,
+// which uses the same reference date as [time.Parse].
+// Values with a time of day other than midnight are rejected.
+// If the value includes a time zone, the date is the one written in the value.
+func ParseDateLayout(layout, value string) (Date, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse date: %v", err)
+	}
+	if h, m, s := t.Clock(); h != 0 || m != 0 || s != 0 || t.Nanosecond() != 0 {
+		return Date{}, fmt.Errorf("parse date %q: has time of day", value)
+	}
+	return DateFromTime(t), nil
+}
+
+// ParseDateList parses a list of dates separated by commas or semicolons,
+// like "2019-02-06, 2019-02-07". Each item is parsed with [ParseDate].
+// If any item fails to parse, ParseDateList returns an error
+// identifying the first such item by its zero-based index.
+func ParseDateList(s string) ([]Date, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, errors.New("parse date list: empty list")
+	}
+	items := strings.Split(strings.ReplaceAll(s, ";", ","), ",")
+	dates := make([]Date, 0, len(items))
+	for i, item := range items {
+		d, err := ParseDate(item)
+		if err != nil {
+			return nil, fmt.Errorf("parse date list: item %d: %v", i, err)
+		}
+		dates = append(dates, d)
+	}
+	return dates, nil
+}
+
+// ParseFutureDate parses a date like [ParseDate],
+// but returns an error if the date is before today.
+// It is intended for validating inputs like expiration dates.
+func ParseFutureDate(s string, today Date) (Date, error) {
+	d, err := ParseDate(s)
+	if err != nil {
+		return Date{}, err
+	}
+	if d.Before(today) {
+		return Date{}, fmt.Errorf("parse date %q: %v is in the past", s, d)
+	}
+	return d, nil
+}
+
+// ParseDateAmbiguous parses a date like [ParseDate],
+// but also reports whether a slash-separated date could be read
+// as either U.S. (month first) or European (day first) order.
+// Ambiguous dates are returned in U.S. order.
+// A slash-separated date whose first component cannot be a month,
+// like "13/02/2019", is read in day-first order and is not ambiguous.
+func ParseDateAmbiguous(s string) (_ Date, ambiguous bool, _ error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		d, err := ParseDate(s)
+		return d, false, err
+	}
+	parts := strings.Split(s, "/")
+	if len(parts) == 3 && len(parts[0]) == 4 {
+		d, err := parseISODateParts(s, parts, false)
+		return d, false, err
+	}
+	first, err1 := strconv.Atoi(parts[0])
+	second, err2 := strconv.Atoi(parts[1])
+	if err1 == nil && err2 == nil && first > 12 && second <= 12 {
+		dayFirst := append([]string{parts[1], parts[0]}, parts[2:]...)
+		d, err := parseUSDateParts(s, dayFirst, false)
+		return d, false, err
+	}
+	d, err := parseUSDateParts(s, parts, false)
+	if err != nil {
+		return Date{}, false, err
 	}
+	return d, first != second && second <= 12, nil
 }
 
-func parseUSDate(s string) (Date, error) {
-	switch parts := strings.Split(s, "/"); len(parts) {
+func parseUSDate(s string, validateDay bool) (Date, error) {
+	return parseUSDateParts(s, strings.Split(s, "/"), validateDay)
+}
+
+func parseUSDateParts(s string, parts []string, validateDay bool) (Date, error) {
+	switch len(parts) {
 	case 2:
 		month, err := strconv.Atoi(parts[0])
 		if err != nil {
@@ -67,11 +330,17 @@ func parseUSDate(s string) (Date, error) {
 		if err != nil {
 			return Date{}, fmt.Errorf("parse US date %q: day: %v", s, err)
 		}
-		if !(1 <= day && day <= 31) {
+		year := currYear()
+		if !(1 <= day && day <= maxDay(year, month, validateDay)) {
 			return Date{}, fmt.Errorf("parse US date %q: invalid day %d", s, day)
 		}
-		return NewDate(currYear(), time.Month(month), day), nil
+		return NewDate(year, time.Month(month), day), nil
 	case 3:
+		if len(parts[0]) == 4 {
+			// A four-digit first component can only be a year,
+			// so the date is in ISO 8601 order with slashes (2006/01/02).
+			return parseISODateParts(s, parts, validateDay)
+		}
 		month, err := strconv.Atoi(parts[0])
 		if err != nil {
 			return Date{}, fmt.Errorf("parse US date %q: month: %v", s, err)
@@ -83,13 +352,13 @@ func parseUSDate(s string) (Date, error) {
 		if err != nil {
 			return Date{}, fmt.Errorf("parse US date %q: day: %v", s, err)
 		}
-		if !(1 <= day && day <= 31) {
-			return Date{}, fmt.Errorf("parse US date %q: invalid day %d", s, day)
-		}
 		year, err := strconv.Atoi(parts[2])
 		if err != nil {
 			return Date{}, fmt.Errorf("parse US date %q: year: %v", s, err)
 		}
+		if !(1 <= day && day <= maxDay(year, month, validateDay)) {
+			return Date{}, fmt.Errorf("parse US date %q: invalid day %d", s, day)
+		}
 		if year < 100 {
 			return Date{}, fmt.Errorf("parse US date %q: short years not allowed", s)
 		}
@@ -99,8 +368,41 @@ func parseUSDate(s string) (Date, error) {
 	}
 }
 
-func parseISODate(s string) (Date, error) {
+// parseDashedDate parses a dash-separated date,
+// which is in U.S. order (1-2-2006) if the first component
+// is one or two digits and in ISO 8601 order otherwise.
+func parseDashedDate(s string, validateDay bool) (Date, error) {
 	parts := strings.Split(s, "-")
+	if len(parts) == 3 && parts[0] != "" && len(parts[0]) <= 2 {
+		if len(parts[2]) <= 2 {
+			return Date{}, fmt.Errorf("parse date %q: ambiguous order", s)
+		}
+		return parseUSDateParts(s, parts, validateDay)
+	}
+	return parseISODate(s, validateDay)
+}
+
+// parseISODate parses an ISO 8601 calendar or ordinal date.
+// The year may have a leading sign and more than four digits,
+// as in the expanded forms "-0753-04-21" and "+10000-01-01".
+func parseISODate(s string, validateDay bool) (Date, error) {
+	var parts []string
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		// Keep the sign with the year rather than splitting on it.
+		parts = strings.Split(s[1:], "-")
+		parts[0] = s[:1] + parts[0]
+	} else {
+		parts = strings.Split(s, "-")
+	}
+	if len(parts) == 2 {
+		return parseISOOrdinalDate(s, parts[0], parts[1])
+	}
+	return parseISODateParts(s, parts, validateDay)
+}
+
+// parseISODateParts parses the year, month, and day components
+// of a calendar date in ISO 8601 order.
+func parseISODateParts(s string, parts []string, validateDay bool) (Date, error) {
 	if len(parts) != 3 {
 		return Date{}, fmt.Errorf("parse ISO date %q: unknown format", s)
 	}
@@ -119,12 +421,124 @@ func parseISODate(s string) (Date, error) {
 	if err != nil {
 		return Date{}, fmt.Errorf("parse ISO date %q: day: %v", s, err)
 	}
-	if !(1 <= day && day <= 31) {
+	if !(1 <= day && day <= maxDay(year, month, validateDay)) {
 		return Date{}, fmt.Errorf("parse ISO date %q: invalid day %d", s, day)
 	}
 	return NewDate(year, time.Month(month), day), nil
 }
 
+func parseDottedDate(s string, validateDay bool) (Date, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return Date{}, fmt.Errorf("parse dotted date %q: unknown format", s)
+	}
+	var yearPart, monthPart, dayPart string
+	switch {
+	case len(parts[0]) == 4:
+		yearPart, monthPart, dayPart = parts[0], parts[1], parts[2]
+	case len(parts[0]) <= 2:
+		dayPart, monthPart, yearPart = parts[0], parts[1], parts[2]
+	default:
+		return Date{}, fmt.Errorf("parse dotted date %q: unknown format", s)
+	}
+	year, err := strconv.Atoi(yearPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse dotted date %q: year: %v", s, err)
+	}
+	if year < 100 {
+		return Date{}, fmt.Errorf("parse dotted date %q: short years not allowed", s)
+	}
+	month, err := strconv.Atoi(monthPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse dotted date %q: month: %v", s, err)
+	}
+	if !(1 <= month && month <= 12) {
+		return Date{}, fmt.Errorf("parse dotted date %q: invalid month %d", s, month)
+	}
+	day, err := strconv.Atoi(dayPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse dotted date %q: day: %v", s, err)
+	}
+	if !(1 <= day && day <= maxDay(year, month, validateDay)) {
+		return Date{}, fmt.Errorf("parse dotted date %q: invalid day %d", s, day)
+	}
+	return NewDate(year, time.Month(month), day), nil
+}
+
+// maxDay returns the largest day of the month accepted by the parsers.
+// If validateDay is false, days up to 31 are accepted in any month
+// and normalized by [NewDate].
+func maxDay(year, month int, validateDay bool) int {
+	if !validateDay {
+		return 31
+	}
+	return DaysInMonth(year, time.Month(month))
+}
+
+// ParseMonthDay parses a date in compact month-day format (0102)
+// occurring in the given year.
+func ParseMonthDay(s string, baseYear int) (Date, error) {
+	if len(s) != 4 || !isDigits(s) {
+		return Date{}, fmt.Errorf("parse month-day %q: unknown format", s)
+	}
+	month, _ := strconv.Atoi(s[:2])
+	if !(1 <= month && month <= 12) {
+		return Date{}, fmt.Errorf("parse month-day %q: invalid month %d", s, month)
+	}
+	day, _ := strconv.Atoi(s[2:])
+	if !(1 <= day && day <= 31) {
+		return Date{}, fmt.Errorf("parse month-day %q: invalid day %d", s, day)
+	}
+	return NewDate(baseYear, time.Month(month), day), nil
+}
+
+// ParseOrdinalCompact parses a date in ISO 8601 basic ordinal format (2006002),
+// that is, a four-digit year followed by a three-digit day of the year.
+func ParseOrdinalCompact(s string) (Date, error) {
+	if len(s) > 7 && strings.ContainsAny(s[7:], ",.") {
+		return Date{}, fmt.Errorf("parse ordinal date %q: fractional days not supported", s)
+	}
+	if len(s) != 7 || !isDigits(s) {
+		return Date{}, fmt.Errorf("parse ordinal date %q: unknown format", s)
+	}
+	year, _ := strconv.Atoi(s[:4])
+	yday, _ := strconv.Atoi(s[4:])
+	if !(1 <= yday && yday <= daysInYear(year)) {
+		return Date{}, fmt.Errorf("parse ordinal date %q: invalid day of year %d", s, yday)
+	}
+	return NewDate(year, time.January, yday), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !('0' <= s[i] && s[i] <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func parseISOOrdinalDate(s string, yearPart, ydayPart string) (Date, error) {
+	year, err := strconv.Atoi(yearPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse ISO date %q: year: %v", s, err)
+	}
+	if strings.ContainsAny(ydayPart, ",.") {
+		return Date{}, fmt.Errorf("parse ISO date %q: fractional days not supported", s)
+	}
+	if len(ydayPart) != 3 {
+		return Date{}, fmt.Errorf("parse ISO date %q: unknown format", s)
+	}
+	yday, err := strconv.Atoi(ydayPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse ISO date %q: day of year: %v", s, err)
+	}
+	if !(1 <= yday && yday <= daysInYear(year)) {
+		return Date{}, fmt.Errorf("parse ISO date %q: invalid day of year %d", s, yday)
+	}
+	return NewDate(year, time.January, yday), nil
+}
+
 // Year returns the year in which d occurs.
 func (d Date) Year() int {
 	return d.year + 1
@@ -140,11 +554,33 @@ func (d Date) Day() int {
 	return d.day + 1
 }
 
+// Weekday returns the day of the week specified by d.
+func (d Date) Weekday() time.Weekday {
+	// January 1, year 1 was a Monday.
+	return time.Weekday(floorMod(d.absDays()+int(time.Monday), 7))
+}
+
 // Equal reports whether d equals d2.
 func (d Date) Equal(d2 Date) bool {
 	return d == d2
 }
 
+// EqualMonth reports whether d and d2 are in the same month of the same year.
+func (d Date) EqualMonth(d2 Date) bool {
+	return d.year == d2.year && d.month == d2.month
+}
+
+// SameDay reports whether d and d2 represent the same calendar day.
+// Unlike [Date.Equal], SameDay normalizes both dates before comparing them,
+// so it is robust against dates with out-of-range fields.
+func (d Date) SameDay(d2 Date) bool {
+	return d.normalize() == d2.normalize()
+}
+
+func (d Date) normalize() Date {
+	return NewDate(d.Year(), d.Month(), d.Day())
+}
+
 // Before reports whether d is before d2.
 func (d Date) Before(d2 Date) bool {
 	if d.year != d2.year {
@@ -156,20 +592,382 @@ func (d Date) Before(d2 Date) bool {
 	return d.day < d2.day
 }
 
+// After reports whether d is after d2.
+func (d Date) After(d2 Date) bool {
+	return d2.Before(d)
+}
+
+// Compare compares d and d2.
+// The result is -1 if d is before d2, 0 if they are equal,
+// and +1 if d is after d2.
+// It can be passed directly to [slices.SortFunc].
+func (d Date) Compare(d2 Date) int {
+	switch {
+	case d.year != d2.year:
+		return cmp.Compare(d.year, d2.year)
+	case d.month != d2.month:
+		return cmp.Compare(d.month, d2.month)
+	default:
+		return cmp.Compare(d.day, d2.day)
+	}
+}
+
+// Clamp returns d bounded to the inclusive range [min, max].
+// If min is after max, Clamp returns min.
+func (d Date) Clamp(min, max Date) Date {
+	switch {
+	case d.Before(min) || max.Before(min):
+		return min
+	case max.Before(d):
+		return max
+	default:
+		return d
+	}
+}
+
+// Min returns the earliest of the given dates
+// or the zero Date if there are none.
+func Min(dates ...Date) Date {
+	if len(dates) == 0 {
+		return Date{}
+	}
+	m := dates[0]
+	for _, d := range dates[1:] {
+		if d.Before(m) {
+			m = d
+		}
+	}
+	return m
+}
+
+// Max returns the latest of the given dates
+// or the zero Date if there are none.
+func Max(dates ...Date) Date {
+	if len(dates) == 0 {
+		return Date{}
+	}
+	m := dates[0]
+	for _, d := range dates[1:] {
+		if d.After(m) {
+			m = d
+		}
+	}
+	return m
+}
+
+// IsSameDay reports whether t occurs on d in t's location.
+func (d Date) IsSameDay(t time.Time) bool {
+	year, month, day := t.Date()
+	return d.Year() == year && d.Month() == month && d.Day() == day
+}
+
+// CompareTime compares the start of d in t's location with t.
+// The start of d is the same as [Date.ToTime],
+// so it accounts for days that do not begin at midnight.
+// The result is -1 if d starts before t, 0 if d starts at t,
+// and +1 if d starts after t.
+func (d Date) CompareTime(t time.Time) int {
+	start := d.ToTime(t.Location())
+	return start.Compare(t)
+}
+
+// ToTime returns the time at midnight at the start of d in loc.
+// A nil loc is treated as [time.UTC].
+// If midnight does not exist in loc because of a daylight saving transition,
+// ToTime returns the first instant of d in loc.
+func (d Date) ToTime(loc *time.Location) time.Time {
+	t := d.AtTime(0, 0, 0, 0, loc)
+	if !d.IsSameDay(t) {
+		// time.Date resolved the skipped midnight to the previous day.
+		// The day starts at the end of the zone in effect at t.
+		_, t = t.ZoneBounds()
+	}
+	return t
+}
+
+// AtTime returns the time on d with the given time of day in loc.
+// Values outside their usual ranges are normalized as in [time.Date].
+// A nil loc is treated as [time.UTC].
+func (d Date) AtTime(hour, min, sec, nsec int, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(d.Year(), d.Month(), d.Day(), hour, min, sec, nsec, loc)
+}
+
 // Add returns the date corresponding
 // to adding the given number of years, months, and days to d.
 func (d Date) Add(years, months, days int) Date {
 	return NewDate(d.Year()+years, d.Month()+time.Month(months), d.Day()+days)
 }
 
+// AddDays returns the date n days after d.
+// Unlike [Date.Add], it counts calendar days directly
+// rather than normalizing the day of the month.
+func (d Date) AddDays(n int) Date {
+	return dateFromAbsDays(d.absDays() + n)
+}
+
+// Sub returns the number of days from d2 to d.
+// The result is positive if d is after d2.
+func (d Date) Sub(d2 Date) int {
+	return d.absDays() - d2.absDays()
+}
+
+// Ordinal returns the number of days from January 1, year 1 to d,
+// so January 1, year 1 is 0 and earlier dates are negative.
+// The result is stable across versions of this package
+// and can be converted back with [DateFromOrdinal].
+// It is unrelated to the ISO 8601 ordinal date format used by [Date.FormatOrdinal].
+func (d Date) Ordinal() int {
+	return d.absDays()
+}
+
+// DateFromOrdinal returns the date n days after January 1, year 1.
+// It is the inverse of [Date.Ordinal].
+func DateFromOrdinal(n int) Date {
+	return dateFromAbsDays(n)
+}
+
+// AddDaysBounded returns the date n days after d
+// if it falls within the inclusive range [min, max].
+// Otherwise, it returns d and false.
+func (d Date) AddDaysBounded(n int, min, max Date) (Date, bool) {
+	result := dateFromAbsDays(d.absDays() + n)
+	if result.Before(min) || max.Before(result) {
+		return d, false
+	}
+	return result, true
+}
+
+// DaysUntilWeekday returns the number of days from d
+// to the next occurrence of the weekday w.
+// It returns 0 if d is already on w.
+func (d Date) DaysUntilWeekday(w time.Weekday) int {
+	return int(w-d.Weekday()+7) % 7
+}
+
+// WeeksAndDaysUntil returns the number of days from d to d2
+// split into whole weeks and remaining days.
+// If d2 is before d, both weeks and days are zero or negative.
+func (d Date) WeeksAndDaysUntil(d2 Date) (weeks, days int) {
+	n := d2.Sub(d)
+	return n / 7, n % 7
+}
+
+// BreakdownUntil returns the calendar span from d to d2
+// as whole years and months followed by whole weeks and remaining days,
+// where months is less than 12 and days is less than 7.
+// Adding months to a date that does not exist in the target month
+// clamps to the end of that month, so the span from January 31 to February 28
+// is one month.
+// If d2 is before d, the result is the negation of d2.BreakdownUntil(d).
+func (d Date) BreakdownUntil(d2 Date) (years, months, weeks, days int) {
+	if d2.Before(d) {
+		years, months, weeks, days = d2.BreakdownUntil(d)
+		return -years, -months, -weeks, -days
+	}
+	totalMonths := (d2.Year()-d.Year())*12 + int(d2.Month()-d.Month())
+	if d.AddMonthsClamped(totalMonths).After(d2) {
+		totalMonths--
+	}
+	n := d2.Sub(d.AddMonthsClamped(totalMonths))
+	return totalMonths / 12, totalMonths % 12, n / 7, n % 7
+}
+
+// AddMonthsClamped returns the date months months after d.
+// If d's day does not exist in the resulting month,
+// the last day of the month is returned,
+// so January 31 plus one month is February 28 (or 29 in a leap year).
+// In contrast, [Date.Add] normalizes such a date into the following month,
+// so January 31 plus one month is March 3 (or March 2 in a leap year).
+func (d Date) AddMonthsClamped(months int) Date {
+	ym := YearMonth{Year: d.Year(), Month: d.Month()}.addMonths(months)
+	return NewDate(ym.Year, ym.Month, min(d.Day(), DaysInMonth(ym.Year, ym.Month)))
+}
+
+// RoundToMonth returns the first day of d's month or of the following month,
+// whichever is nearer to d.
+// A date exactly halfway between the two, like April 16,
+// rounds to the following month.
+func (d Date) RoundToMonth() Date {
+	elapsed := d.Day() - 1
+	remaining := DaysInMonth(d.Year(), d.Month()) - elapsed
+	if elapsed < remaining {
+		return NewDate(d.Year(), d.Month(), 1)
+	}
+	return NewDate(d.Year(), d.Month()+1, 1)
+}
+
+// StartOfMonth returns the first day of d's month.
+func (d Date) StartOfMonth() Date {
+	return Date{year: d.year, month: d.month}
+}
+
+// EndOfMonth returns the last day of d's month.
+func (d Date) EndOfMonth() Date {
+	return Date{year: d.year, month: d.month, day: d.DaysInMonth() - 1}
+}
+
+// StartOfYear returns January 1 of d's year.
+func (d Date) StartOfYear() Date {
+	return Date{year: d.year}
+}
+
+// EndOfYear returns December 31 of d's year.
+func (d Date) EndOfYear() Date {
+	return Date{year: d.year, month: 11, day: 30}
+}
+
+// StartOfDecade returns January 1 of the first year of d's decade.
+// Decades are counted from years divisible by 10,
+// so the decade of 2019 is 2010–2019.
+func (d Date) StartOfDecade() Date {
+	return NewDate(floorDiv(d.Year(), 10)*10, time.January, 1)
+}
+
+// EndOfDecade returns December 31 of the last year of d's decade.
+// Decades are counted as in [Date.StartOfDecade].
+func (d Date) EndOfDecade() Date {
+	return NewDate(floorDiv(d.Year(), 10)*10+9, time.December, 31)
+}
+
+// StartOfCentury returns January 1 of the first year of d's century.
+// Centuries are counted from years divisible by 100,
+// so the century of 2019 is 2000–2099.
+func (d Date) StartOfCentury() Date {
+	return NewDate(floorDiv(d.Year(), 100)*100, time.January, 1)
+}
+
+// EndOfCentury returns December 31 of the last year of d's century.
+// Centuries are counted as in [Date.StartOfCentury].
+func (d Date) EndOfCentury() Date {
+	return NewDate(floorDiv(d.Year(), 100)*100+99, time.December, 31)
+}
+
+// IsLeapYear reports whether d is in a leap year.
+// See [LeapYear] for details.
+func (d Date) IsLeapYear() bool {
+	return LeapYear(d.Year())
+}
+
+// YearDay returns the day of the year specified by d,
+// in the range [1,365] for non-leap years, and [1,366] in leap years.
+func (d Date) YearDay() int {
+	n := int(daysBeforeMonthTable[d.month]) + d.Day()
+	if d.month > 1 && LeapYear(d.Year()) {
+		n++
+	}
+	return n
+}
+
+// DaysInMonth returns the number of days in d's month.
+func (d Date) DaysInMonth() int {
+	return DaysInMonth(d.Year(), d.Month())
+}
+
+// IsLeapDay reports whether d is February 29.
+func (d Date) IsLeapDay() bool {
+	return d.Month() == time.February && d.Day() == 29
+}
+
+// NextLeapDay returns the first February 29 on or after d.
+func (d Date) NextLeapDay() Date {
+	year := d.Year()
+	if d.Month() > time.February {
+		year++
+	}
+	for !LeapYear(year) {
+		year++
+	}
+	return NewDate(year, time.February, 29)
+}
+
+// UnixNanoUTC returns the number of nanoseconds elapsed
+// from January 1, 1970 UTC to midnight UTC on d.
+// The result is undefined if the value cannot be represented by an int64
+// (dates before 1677-09-22 or after 2262-04-11).
+// See [Date.CheckedUnixNanoUTC] for a variant that reports overflow.
+func (d Date) UnixNanoUTC() int64 {
+	return int64(d.absDays()-unixEpochAbsDays) * int64(24*time.Hour)
+}
+
+// CheckedUnixNanoUTC is like [Date.UnixNanoUTC],
+// but returns an error if the result cannot be represented by an int64.
+func (d Date) CheckedUnixNanoUTC() (int64, error) {
+	const maxDays = math.MaxInt64 / int64(24*time.Hour)
+	days := int64(d.absDays() - unixEpochAbsDays)
+	if !(-maxDays <= days && days <= maxDays) {
+		return 0, fmt.Errorf("%v is out of range for Unix nanoseconds", d)
+	}
+	return days * int64(24*time.Hour), nil
+}
+
+// ParseEpochDay parses a decimal integer as the number of days
+// since January 1, 1970, like "17933" for 2019-02-06.
+// Negative numbers are dates before 1970.
+// Counts larger in magnitude than [math.MaxInt32] are rejected.
+func ParseEpochDay(s string) (Date, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse epoch day %q: %v", s, err)
+	}
+	if !(-math.MaxInt32 <= n && n <= math.MaxInt32) {
+		return Date{}, fmt.Errorf("parse epoch day %q: out of range", s)
+	}
+	return dateFromAbsDays(unixEpochAbsDays + int(n)), nil
+}
+
 // IsZero reports whether d is the zero value.
 func (d Date) IsZero() bool {
 	return d == Date{}
 }
 
 // String returns the date in ISO 8601 format, like "2006-01-02".
+// Years outside the range [0,9999] use the ISO 8601 expanded format
+// with a sign and at least four digits, like "-0753-04-21" or "+10000-01-01".
 func (d Date) String() string {
-	return fmt.Sprintf("%04d-%02d-%02d", d.Year(), int(d.Month()), d.Day())
+	return fmt.Sprintf("%s-%02d-%02d", formatYear(d.Year()), int(d.Month()), d.Day())
+}
+
+// Format returns the date formatted according to layout,
+// which uses the same reference date as [time.Time.Format].
+// Any time of day elements in the layout are formatted as midnight UTC.
+//
+// [ParseDateLayout] with the same layout returns d
+// as long as the layout includes the day (as day of month or day of year),
+// the month (if using day of month), and the four-digit year,
+// and d's year is in the range [0,9999].
+// Layouts with a two-digit year only round-trip years 1969 through 2068.
+func (d Date) Format(layout string) string {
+	return d.ToTime(time.UTC).Format(layout)
+}
+
+// FormatOrdinal returns the date in ISO 8601 ordinal format, like "2006-002".
+// Years outside the range [0,9999] are formatted as in [Date.String].
+// [ParseDate] accepts the result.
+func (d Date) FormatOrdinal() string {
+	return fmt.Sprintf("%s-%03d", formatYear(d.Year()), d.YearDay())
+}
+
+// formatYear formats an ISO 8601 year:
+// four digits for years in [0,9999]
+// or a sign followed by at least four digits otherwise.
+func formatYear(year int) string {
+	if 0 <= year && year <= 9999 {
+		return fmt.Sprintf("%04d", year)
+	}
+	return fmt.Sprintf("%+05d", year)
+}
+
+// StringOrEmpty returns the empty string if d is the zero value
+// or the date in ISO 8601 format otherwise.
+// It is useful for displaying dates that may not be set.
+func (d Date) StringOrEmpty() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.String()
 }
 
 // MarshalText returns the date in ISO 8601 format, like "2006-01-02".
@@ -178,10 +976,260 @@ func (d Date) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText parses the date from ISO 8601 format, like "2006-01-02".
+// Surrounding whitespace and a leading UTF-8 byte order mark are ignored.
 func (d *Date) UnmarshalText(data []byte) error {
+	s := strings.TrimPrefix(string(data), "\uFEFF")
 	var err error
-	*d, err = parseISODate(string(data))
+	*d, err = parseISODate(strings.TrimSpace(s), false)
 	return err
 }
 
+// MarshalJSON returns the date as a JSON string in ISO 8601 format,
+// like "2006-01-02".
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON parses the date from a JSON string in ISO 8601 format,
+// like "2006-01-02". As is the convention for [json.Unmarshaler],
+// a JSON null leaves d unchanged.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) == 0 || data[0] != '"' {
+		return fmt.Errorf("unmarshal date: %s is not a JSON string", data)
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("unmarshal date: %v", err)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// Value returns the date as a [time.Time] at midnight UTC.
+// It implements [driver.Valuer].
+func (d Date) Value() (driver.Value, error) {
+	return d.ToTime(time.UTC), nil
+}
+
+// Scan sets d from a database value.
+// It implements [database/sql.Scanner].
+// A [time.Time] is converted with [DateFromTime],
+// a string or byte slice is parsed with [ParseDate],
+// and nil sets d to the zero value.
+func (d *Date) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = DateFromTime(src)
+		return nil
+	case string:
+		return d.scanString(src)
+	case []byte:
+		return d.scanString(string(src))
+	default:
+		return fmt.Errorf("scan date: unsupported type %T", src)
+	}
+}
+
+func (d *Date) scanString(s string) error {
+	parsed, err := ParseDate(s)
+	if err != nil {
+		return fmt.Errorf("scan date: %v", err)
+	}
+	*d = parsed
+	return nil
+}
+
+// LeapYear reports whether year is a leap year in the Gregorian calendar:
+// a year divisible by 4, except for years divisible by 100
+// but not by 400. Years use astronomical year numbering as in [Date],
+// so year 0 (1 BC) is a leap year.
+func LeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func daysInYear(year int) int {
+	if LeapYear(year) {
+		return 366
+	}
+	return 365
+}
+
+// DaysInMonth returns the number of days in the given month.
+// Months outside the range January through December
+// are normalized into an adjacent year, as in [NewDate].
+func DaysInMonth(year int, month time.Month) int {
+	if !(time.January <= month && month <= time.December) {
+		year += floorDiv(int(month)-1, 12)
+		month = time.Month(floorMod(int(month)-1, 12) + 1)
+	}
+	if month == time.February && LeapYear(year) {
+		return 29
+	}
+	return int(daysInMonthTable[month-1])
+}
+
+var daysInMonthTable = [12]int8{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// daysBeforeMonthTable is the number of days in a non-leap year
+// before the first day of each month.
+var daysBeforeMonthTable = [12]int16{0, 31, 59, 90, 120, 151, 181, 212, 243, 273, 304, 334}
+
+// unixEpochAbsDays is the number of days from January 1, year 1
+// to January 1, 1970.
+const unixEpochAbsDays = 719162
+
+// absDays returns the number of days since January 1, year 1.
+func (d Date) absDays() int {
+	return daysFromCivil(d.Year(), d.Month(), d.Day()) - daysFromCivil(1, time.January, 1)
+}
+
+// dateFromAbsDays returns the date that is n days after January 1, year 1.
+func dateFromAbsDays(n int) Date {
+	return civilFromDays(n + daysFromCivil(1, time.January, 1))
+}
+
+// daysFromCivil returns the number of days since March 1, year 0
+// in the proleptic Gregorian calendar.
+// See https://howardhinnant.github.io/date_algorithms.html#days_from_civil
+func daysFromCivil(year int, month time.Month, day int) int {
+	m := int(month)
+	if m <= 2 {
+		year--
+		m += 12
+	}
+	era := floorDiv(year, 400)
+	yoe := year - era*400
+	doy := (153*(m-3)+2)/5 + day - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe
+}
+
+// civilFromDays is the inverse of [daysFromCivil].
+// See https://howardhinnant.github.io/date_algorithms.html#civil_from_days
+func civilFromDays(n int) Date {
+	era := floorDiv(n, 146097)
+	doe := n - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	day := doy - (153*mp+2)/5 + 1
+	month := mp + 3
+	year := yoe + era*400
+	if month > 12 {
+		month -= 12
+		year++
+	}
+	return Date{year: year - 1, month: month - 1, day: day - 1}
+}
+
+func floorDiv(x, y int) int {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
+
+func floorMod(x, y int) int {
+	return x - floorDiv(x, y)*y
+}
+
+// Range of years representable by [Date.Pack24].
+const (
+	pack24MinYear = 2000
+	pack24MaxYear = pack24MinYear + 1<<15 - 1
+)
+
+// Pack24 encodes d into the low 24 bits of a uint32.
+// Bits 0–4 hold the day of the month, bits 5–8 hold the month,
+// and bits 9–23 hold the number of years since 2000,
+// so packed dates sort in chronological order.
+// Pack24 returns an error if d's year is outside the range 2000–34767.
+func (d Date) Pack24() (uint32, error) {
+	year := d.Year()
+	if !(pack24MinYear <= year && year <= pack24MaxYear) {
+		return 0, fmt.Errorf("pack %v: year outside range [%d, %d]", d, pack24MinYear, pack24MaxYear)
+	}
+	return uint32(year-pack24MinYear)<<9 | uint32(d.Month())<<5 | uint32(d.Day()), nil
+}
+
+// Unpack24 decodes a date encoded by [Date.Pack24].
+func Unpack24(v uint32) (Date, error) {
+	if v >= 1<<24 {
+		return Date{}, fmt.Errorf("unpack date %#x: more than 24 bits", v)
+	}
+	year := int(v>>9) + pack24MinYear
+	month := time.Month(v >> 5 & 0xf)
+	day := int(v & 0x1f)
+	if !(time.January <= month && month <= time.December) {
+		return Date{}, fmt.Errorf("unpack date %#x: invalid month %d", v, int(month))
+	}
+	if !(1 <= day && day <= DaysInMonth(year, month)) {
+		return Date{}, fmt.Errorf("unpack date %#x: invalid day %d", v, day)
+	}
+	return NewDate(year, month, day), nil
+}
+
+// binaryDateLen is the length of the encodings produced
+// by [Date.MarshalBinary] and [Date.GobEncode].
+const binaryDateLen = 4
+
+// MarshalBinary encodes the date as the number of days since January 1, year 1
+// in a 4-byte big-endian two's complement integer.
+// MarshalBinary returns an error if the number of days does not fit.
+func (d Date) MarshalBinary() ([]byte, error) {
+	n := d.absDays()
+	if !(math.MinInt32 <= n && n <= math.MaxInt32) {
+		return nil, fmt.Errorf("marshal %v: out of range", d)
+	}
+	return binary.BigEndian.AppendUint32(make([]byte, 0, binaryDateLen), uint32(int32(n))), nil
+}
+
+// UnmarshalBinary decodes a date encoded by [Date.MarshalBinary].
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != binaryDateLen {
+		return fmt.Errorf("unmarshal date: got %d bytes; want %d", len(data), binaryDateLen)
+	}
+	*d = dateFromAbsDays(int(int32(binary.BigEndian.Uint32(data))))
+	return nil
+}
+
+// GobEncode encodes the date for [encoding/gob]
+// as the number of days since January 1, year 1
+// in a 4-byte little-endian two's complement integer.
+// This format is independent of [Date.MarshalBinary] and will not change.
+func (d Date) GobEncode() ([]byte, error) {
+	n := d.absDays()
+	if !(math.MinInt32 <= n && n <= math.MaxInt32) {
+		return nil, fmt.Errorf("gob encode %v: out of range", d)
+	}
+	return binary.LittleEndian.AppendUint32(make([]byte, 0, binaryDateLen), uint32(int32(n))), nil
+}
+
+// GobDecode decodes a date encoded by [Date.GobEncode].
+func (d *Date) GobDecode(data []byte) error {
+	if len(data) != binaryDateLen {
+		return fmt.Errorf("gob decode date: got %d bytes; want %d", len(data), binaryDateLen)
+	}
+	*d = dateFromAbsDays(int(int32(binary.LittleEndian.Uint32(data))))
+	return nil
+}
+
+// Set parses s using [ParseDate] and stores the result in d.
+// Together with [Date.String], it implements [flag.Value].
+func (d *Date) Set(s string) error {
+	parsed, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
 var currYear = func() int { return time.Now().Year() }
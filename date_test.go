@@ -17,8 +17,16 @@
 package gregorian
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"flag"
+	"io"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+	_ "time/tzdata"
 )
 
 func TestValidateDate(t *testing.T) {
@@ -46,6 +54,44 @@ func TestValidateDate(t *testing.T) {
 		{s: "00/01/2019", currYear: 2020, wantErr: true},
 		{s: "06/00/2019", currYear: 2020, wantErr: true},
 		{s: "06/32/2019", currYear: 2020, wantErr: true},
+		{s: "-0753-04-21", currYear: 2020, want: NewDate(-753, time.April, 21)},
+		{s: "-753-04-21", currYear: 2020, want: NewDate(-753, time.April, 21)},
+		{s: "+10000-01-01", currYear: 2020, want: NewDate(10000, time.January, 1)},
+		{s: "10000-01-01", currYear: 2020, want: NewDate(10000, time.January, 1)},
+		{s: "+2019-02-06", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "-0001-12-31", currYear: 2020, want: NewDate(-1, time.December, 31)},
+		{s: "-0004-02-29", currYear: 2020, want: NewDate(-4, time.February, 29)},
+		{s: "-0753-111", currYear: 2020, want: NewDate(-753, time.April, 21)},
+		{s: "--0753-04-21", currYear: 2020, wantErr: true},
+		{s: "-0753-13-21", currYear: 2020, wantErr: true},
+		{s: "-", currYear: 2020, wantErr: true},
+		{s: "2019/02/06", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "2019/2/6", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "02/06/2019", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "2019/13/06", currYear: 2020, wantErr: true},
+		{s: "2019/02/32", currYear: 2020, wantErr: true},
+		{s: "2019/02", currYear: 2020, wantErr: true},
+		{s: "2019-037", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "2020-366", currYear: 2020, want: NewDate(2020, time.December, 31)},
+		{s: "2019-366", currYear: 2020, wantErr: true},
+		{s: "2019-000", currYear: 2020, wantErr: true},
+		{s: "2019-37", currYear: 2020, wantErr: true},
+		{s: "2019-037,5", currYear: 2020, wantErr: true},
+		{s: "2019-037.5", currYear: 2020, wantErr: true},
+		{s: "2019.02.06", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "2019.2.6", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "06.02.2019", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "6.2.2019", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "6.2", currYear: 2020, wantErr: true},
+		{s: "06.02.19", currYear: 2020, wantErr: true},
+		{s: "2019.13.06", currYear: 2020, wantErr: true},
+		{s: "32.01.2019", currYear: 2020, wantErr: true},
+		{s: "201.02.06", currYear: 2020, wantErr: true},
+		{s: "2-6-2019", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "02-06-2019", currYear: 2020, want: NewDate(2019, time.February, 6)},
+		{s: "13-06-2019", currYear: 2020, wantErr: true},
+		{s: "02-06-19", currYear: 2020, wantErr: true},
+		{s: "2-6", currYear: 2020, wantErr: true},
 	}
 
 	defer func(oldCurrYear func() int) {
@@ -63,3 +109,1792 @@ func TestValidateDate(t *testing.T) {
 		}
 	}
 }
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want string
+	}{
+		{d: NewDate(2019, time.February, 6), want: "2019-02-06"},
+		{d: NewDate(900, time.April, 10), want: "0900-04-10"},
+		{d: NewDate(0, time.January, 1), want: "0000-01-01"},
+		{d: NewDate(9999, time.December, 31), want: "9999-12-31"},
+		{d: NewDate(-1, time.December, 31), want: "-0001-12-31"},
+		{d: NewDate(-753, time.April, 21), want: "-0753-04-21"},
+		{d: NewDate(10000, time.January, 1), want: "+10000-01-01"},
+	}
+	for _, test := range tests {
+		if got := test.d.String(); got != test.want {
+			t.Errorf("%v.String() = %q; want %q", test.d, got, test.want)
+		}
+		text, err := test.d.MarshalText()
+		if err != nil {
+			t.Errorf("%v.MarshalText() = _, %v", test.d, err)
+			continue
+		}
+		var got Date
+		if err := got.UnmarshalText(text); err != nil || got != test.d {
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v, <nil>", text, got, err, test.d)
+		}
+	}
+}
+
+func TestStringOrEmpty(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want string
+	}{
+		{d: Date{}, want: ""},
+		{d: NewDate(2019, time.February, 6), want: "2019-02-06"},
+	}
+	for _, test := range tests {
+		if got := test.d.StringOrEmpty(); got != test.want {
+			t.Errorf("%v.StringOrEmpty() = %q; want %q", test.d, got, test.want)
+		}
+	}
+}
+
+func TestParseMonthDay(t *testing.T) {
+	tests := []struct {
+		s        string
+		baseYear int
+		want     Date
+		wantErr  bool
+	}{
+		{s: "0206", baseYear: 2019, want: NewDate(2019, time.February, 6)},
+		{s: "1231", baseYear: 2020, want: NewDate(2020, time.December, 31)},
+		{s: "1301", baseYear: 2019, wantErr: true},
+		{s: "0001", baseYear: 2019, wantErr: true},
+		{s: "0200", baseYear: 2019, wantErr: true},
+		{s: "0232", baseYear: 2019, wantErr: true},
+		{s: "206", baseYear: 2019, wantErr: true},
+		{s: "02-6", baseYear: 2019, wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseMonthDay(test.s, test.baseYear)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseMonthDay(%q, %d) = %v, %v; want %v, %s", test.s, test.baseYear, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestAbsDays(t *testing.T) {
+	epoch := time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, year := range []int{-401, -1, 0, 1, 4, 100, 1600, 1900, 1970, 2000, 2019, 2100, 9999} {
+		for _, month := range []time.Month{time.January, time.February, time.March, time.December} {
+			for _, day := range []int{1, 28, 29} {
+				d := NewDate(year, month, day)
+				tm := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+				want := int((tm.Unix() - epoch.Unix()) / (24 * 60 * 60))
+				if got := d.absDays(); got != want {
+					t.Errorf("%v.absDays() = %d; want %d", d, got, want)
+				}
+				if got := dateFromAbsDays(want); got != d {
+					t.Errorf("dateFromAbsDays(%d) = %v; want %v", want, got, d)
+				}
+				if got, want := d.Weekday(), tm.Weekday(); got != want {
+					t.Errorf("%v.Weekday() = %v; want %v", d, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestParseOrdinalCompact(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{s: "2019037", want: NewDate(2019, time.February, 6)},
+		{s: "2019001", want: NewDate(2019, time.January, 1)},
+		{s: "2020366", want: NewDate(2020, time.December, 31)},
+		{s: "2023365", want: NewDate(2023, time.December, 31)},
+		{s: "2023366", wantErr: true},
+		{s: "2023000", wantErr: true},
+		{s: "20190206", wantErr: true},
+		{s: "2019-037", wantErr: true},
+		{s: "2019037,5", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseOrdinalCompact(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseOrdinalCompact(%q) = %v, %v; want %v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestIsSameDay(t *testing.T) {
+	d := NewDate(2019, time.February, 6)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	newYork := time.FixedZone("EST", -5*60*60)
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{t: time.Date(2019, time.February, 6, 0, 0, 0, 0, tokyo), want: true},
+		{t: time.Date(2019, time.February, 6, 23, 59, 59, 999999999, tokyo), want: true},
+		{t: time.Date(2019, time.February, 5, 23, 59, 59, 999999999, tokyo), want: false},
+		{t: time.Date(2019, time.February, 7, 0, 0, 0, 0, tokyo), want: false},
+		{t: time.Date(2019, time.February, 6, 23, 30, 0, 0, newYork), want: true},
+		// Same instant as above, but in UTC it is already February 7.
+		{t: time.Date(2019, time.February, 7, 4, 30, 0, 0, time.UTC), want: false},
+		// Same instant as the first Tokyo case, but in UTC it is still February 5.
+		{t: time.Date(2019, time.February, 5, 15, 0, 0, 0, time.UTC), want: false},
+	}
+	for _, test := range tests {
+		if got := d.IsSameDay(test.t); got != test.want {
+			t.Errorf("%v.IsSameDay(%v) = %t; want %t", d, test.t, got, test.want)
+		}
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{s: "2019-02-06", want: "2019-02-06"},
+		{s: "2019-2-6", want: "2019-02-06"},
+		{s: " 2019-02-06\n", want: "2019-02-06"},
+		{s: "2/6/2019", want: "2019-02-06"},
+		{s: "02/06/2019", want: "2019-02-06"},
+		{s: "", wantErr: true},
+		{s: "Feb 6", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := Canonicalize(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("Canonicalize(%q) = %q, %v; want %q, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestAddDaysBounded(t *testing.T) {
+	min := NewDate(2019, time.January, 1)
+	max := NewDate(2019, time.December, 31)
+	tests := []struct {
+		d      Date
+		n      int
+		want   Date
+		wantOK bool
+	}{
+		{d: NewDate(2019, time.February, 6), n: 0, want: NewDate(2019, time.February, 6), wantOK: true},
+		{d: NewDate(2019, time.February, 6), n: 7, want: NewDate(2019, time.February, 13), wantOK: true},
+		{d: NewDate(2019, time.February, 6), n: 30, want: NewDate(2019, time.March, 8), wantOK: true},
+		{d: NewDate(2019, time.December, 24), n: 7, want: NewDate(2019, time.December, 31), wantOK: true},
+		{d: NewDate(2019, time.December, 25), n: 7, want: NewDate(2019, time.December, 25), wantOK: false},
+		{d: NewDate(2019, time.January, 7), n: -6, want: NewDate(2019, time.January, 1), wantOK: true},
+		{d: NewDate(2019, time.January, 7), n: -7, want: NewDate(2019, time.January, 7), wantOK: false},
+	}
+	for _, test := range tests {
+		got, ok := test.d.AddDaysBounded(test.n, min, max)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("%v.AddDaysBounded(%d, %v, %v) = %v, %t; want %v, %t", test.d, test.n, min, max, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func TestRegisterParser(t *testing.T) {
+	customParsers.mu.Lock()
+	oldList := customParsers.list
+	customParsers.mu.Unlock()
+	t.Cleanup(func() {
+		customParsers.mu.Lock()
+		customParsers.list = oldList
+		customParsers.mu.Unlock()
+	})
+
+	dateOnly := func(d Date) func(string) (Date, error) {
+		return func(string) (Date, error) { return d, nil }
+	}
+	hasPrefix := func(prefix string) func(string) bool {
+		return func(s string) bool { return strings.HasPrefix(s, prefix) }
+	}
+	RegisterParser(hasPrefix("today"), dateOnly(NewDate(2019, time.February, 6)))
+	RegisterParser(hasPrefix("to"), dateOnly(NewDate(2000, time.January, 1)))
+	RegisterParser(hasPrefix("2019"), dateOnly(NewDate(2000, time.January, 1)))
+	RegisterParser(hasPrefix("R"), ParseJapaneseEra)
+
+	tests := []struct {
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{s: "today", want: NewDate(2019, time.February, 6)},
+		{s: " today ", want: NewDate(2019, time.February, 6)},
+		{s: "tomorrow", want: NewDate(2000, time.January, 1)},
+		{s: "2019-02-07", want: NewDate(2019, time.February, 7)},
+		// Built-in formats that fail fall back to registered parsers.
+		{s: "2019-13-07", want: NewDate(2000, time.January, 1)},
+		{s: "R1.5.1", want: NewDate(2019, time.May, 1)},
+		{s: "R1/5/1", want: NewDate(2019, time.May, 1)},
+		{s: "yesterday", wantErr: true},
+		{s: "2/30/19", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDate(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseDate(%q) = %v, %v; want %v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestSameDay(t *testing.T) {
+	tests := []struct {
+		d, d2 Date
+		want  bool
+	}{
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 6), want: true},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 7), want: false},
+		// February 31, 2019
+		{d: Date{year: 2018, month: 1, day: 30}, d2: NewDate(2019, time.March, 3), want: true},
+		{d: NewDate(2019, time.March, 3), d2: Date{year: 2018, month: 1, day: 30}, want: true},
+		// Month 13 of 2018
+		{d: Date{year: 2017, month: 12, day: 5}, d2: NewDate(2019, time.January, 6), want: true},
+		{d: Date{year: 2018, month: 1, day: 30}, d2: NewDate(2019, time.March, 4), want: false},
+	}
+	for _, test := range tests {
+		if got := test.d.SameDay(test.d2); got != test.want {
+			t.Errorf("%v.SameDay(%v) = %t; want %t", test.d, test.d2, got, test.want)
+		}
+	}
+}
+
+func TestPack24(t *testing.T) {
+	dates := []Date{
+		NewDate(2000, time.January, 1),
+		NewDate(2019, time.February, 6),
+		NewDate(2020, time.February, 29),
+		NewDate(2127, time.December, 31),
+		NewDate(34767, time.December, 31),
+	}
+	var prev uint32
+	for i, d := range dates {
+		v, err := d.Pack24()
+		if err != nil {
+			t.Errorf("%v.Pack24(): %v", d, err)
+			continue
+		}
+		if v >= 1<<24 {
+			t.Errorf("%v.Pack24() = %#x; want < 1<<24", d, v)
+		}
+		if i > 0 && v <= prev {
+			t.Errorf("%v.Pack24() = %#x; want > %#x (packed %v)", d, v, prev, dates[i-1])
+		}
+		prev = v
+		got, err := Unpack24(v)
+		if got != d || err != nil {
+			t.Errorf("Unpack24(%#x) = %v, %v; want %v, <nil>", v, got, err, d)
+		}
+	}
+
+	for _, d := range []Date{NewDate(1999, time.December, 31), NewDate(34768, time.January, 1)} {
+		if v, err := d.Pack24(); err == nil {
+			t.Errorf("%v.Pack24() = %#x, <nil>; want _, <non-nil>", d, v)
+		}
+	}
+}
+
+func TestUnpack24Invalid(t *testing.T) {
+	tests := []uint32{
+		0,                 // month 0, day 0
+		1 << 5,            // January 0
+		13<<5 | 1,         // month 13
+		2<<5 | 30,         // February 30, 2000
+		19<<9 | 2<<5 | 29, // February 29, 2019
+		1 << 24,
+	}
+	for _, v := range tests {
+		if got, err := Unpack24(v); err == nil {
+			t.Errorf("Unpack24(%#x) = %v, <nil>; want _, <non-nil>", v, got)
+		}
+	}
+}
+
+func TestParseEpochDay(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{s: "0", want: NewDate(1970, time.January, 1)},
+		{s: "-1", want: NewDate(1969, time.December, 31)},
+		{s: "1", want: NewDate(1970, time.January, 2)},
+		{s: "17933", want: NewDate(2019, time.February, 6)},
+		{s: " 17933\n", want: NewDate(2019, time.February, 6)},
+		{s: "-719162", want: NewDate(1, time.January, 1)},
+		{s: "", wantErr: true},
+		{s: "1.5", wantErr: true},
+		{s: "2019-02-06", wantErr: true},
+		{s: "abc", wantErr: true},
+		{s: "99999999999", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseEpochDay(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseEpochDay(%q) = %v, %v; want %v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+
+	// ParseDate does not accept epoch days.
+	if got, err := ParseDate("17933"); err == nil {
+		t.Errorf("ParseDate(\"17933\") = %v, <nil>; want _, <non-nil>", got)
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{s: "2019-02-06", want: NewDate(2019, time.February, 6)},
+		{s: "  2019-02-06 ", want: NewDate(2019, time.February, 6)},
+		{s: "\t2019-02-06\r\n", want: NewDate(2019, time.February, 6)},
+		{s: "\uFEFF2019-02-06", want: NewDate(2019, time.February, 6)},
+		{s: "\uFEFF 2019-02-06 ", want: NewDate(2019, time.February, 6)},
+		{s: "", wantErr: true},
+		{s: "2/6/2019", wantErr: true},
+	}
+	for _, test := range tests {
+		var got Date
+		err := got.UnmarshalText([]byte(test.s))
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestBinary(t *testing.T) {
+	dates := []Date{
+		{},
+		NewDate(1970, time.January, 1),
+		NewDate(2019, time.February, 6),
+		NewDate(2020, time.February, 29),
+		NewDate(-43, time.March, 15),
+		NewDate(9999, time.December, 31),
+	}
+	for _, d := range dates {
+		data, err := d.MarshalBinary()
+		if err != nil {
+			t.Errorf("%v.MarshalBinary(): %v", d, err)
+			continue
+		}
+		if len(data) != 4 {
+			t.Errorf("len(%v.MarshalBinary()) = %d; want 4", d, len(data))
+		}
+		var got Date
+		if err := got.UnmarshalBinary(data); err != nil || got != d {
+			t.Errorf("UnmarshalBinary(%x) = %v, %v; want %v, <nil>", data, got, err, d)
+		}
+	}
+
+	// Encodings sort in chronological order for dates since year 1.
+	a, _ := NewDate(2019, time.February, 6).MarshalBinary()
+	b, _ := NewDate(2019, time.February, 7).MarshalBinary()
+	if string(a) >= string(b) {
+		t.Errorf("MarshalBinary: %x >= %x", a, b)
+	}
+
+	var d Date
+	for _, data := range [][]byte{nil, {0, 0, 0}, {0, 0, 0, 0, 0}} {
+		if err := d.UnmarshalBinary(data); err == nil {
+			t.Errorf("UnmarshalBinary(%x) = <nil>; want error", data)
+		}
+	}
+	if data, err := NewDate(10_000_000, time.January, 1).MarshalBinary(); err == nil {
+		t.Errorf("MarshalBinary() of year 10000000 = %x, <nil>; want error", data)
+	}
+}
+
+func TestGob(t *testing.T) {
+	want := []Date{
+		{},
+		NewDate(1970, time.January, 1),
+		NewDate(2019, time.February, 6),
+		NewDate(-43, time.March, 15),
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatal(err)
+	}
+	var got []Date
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("gob round trip = %v; want %v", got, want)
+	}
+}
+
+func TestGobDecodeFixed(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want Date
+	}{
+		{data: []byte{0x00, 0x00, 0x00, 0x00}, want: NewDate(1, time.January, 1)},
+		{data: []byte{0x3a, 0xf9, 0x0a, 0x00}, want: NewDate(1970, time.January, 1)},
+		{data: []byte{0x47, 0x3f, 0x0b, 0x00}, want: NewDate(2019, time.February, 6)},
+		{data: []byte{0xff, 0xff, 0xff, 0xff}, want: NewDate(0, time.December, 31)},
+	}
+	for _, test := range tests {
+		var got Date
+		if err := got.GobDecode(test.data); err != nil || got != test.want {
+			t.Errorf("GobDecode(%x) = %v, %v; want %v, <nil>", test.data, got, err, test.want)
+		}
+		if data, err := test.want.GobEncode(); err != nil || !bytes.Equal(data, test.data) {
+			t.Errorf("%v.GobEncode() = %x, %v; want %x, <nil>", test.want, data, err, test.data)
+		}
+	}
+
+	var d Date
+	if err := d.GobDecode([]byte{1, 2, 3}); err == nil {
+		t.Error("GobDecode of 3 bytes did not return an error")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type event struct {
+		Name string
+		Date Date
+	}
+	got, err := json.Marshal(event{Name: "launch", Date: NewDate(2019, time.February, 6)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Name":"launch","Date":"2019-02-06"}`; string(got) != want {
+		t.Errorf("json.Marshal(...) = %s; want %s", got, want)
+	}
+
+	tests := []struct {
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{s: `{"Date":"2019-02-06"}`, want: NewDate(2019, time.February, 6)},
+		{s: `{"Date": "2019-02-06" }`, want: NewDate(2019, time.February, 6)},
+		{s: `{"Date":" 2019-02-06 "}`, want: NewDate(2019, time.February, 6)},
+		{s: `{"Date":"\u0032019-02-06"}`, want: NewDate(2019, time.February, 6)},
+		{s: `{"Date":null}`, want: Date{}},
+		{s: `{}`, want: Date{}},
+		{s: `{"Date":20190206}`, wantErr: true},
+		{s: `{"Date":true}`, wantErr: true},
+		{s: `{"Date":""}`, wantErr: true},
+		{s: `{"Date":"2/6/2019"}`, wantErr: true},
+	}
+	for _, test := range tests {
+		var got event
+		err := json.Unmarshal([]byte(test.s), &got)
+		if got.Date != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("json.Unmarshal(%q) = %v, %v; want %v, %s", test.s, got.Date, err, test.want, wantErr)
+		}
+	}
+
+	// A null leaves the existing value alone.
+	d := NewDate(2019, time.February, 6)
+	if err := d.UnmarshalJSON([]byte(" null ")); err != nil || d != NewDate(2019, time.February, 6) {
+		t.Errorf("UnmarshalJSON(null) = %v, %v; want 2019-02-06, <nil>", d, err)
+	}
+}
+
+func TestValue(t *testing.T) {
+	d := NewDate(2019, time.February, 6)
+	got, err := d.Value()
+	if want := time.Date(2019, time.February, 6, 0, 0, 0, 0, time.UTC); err != nil || got != want {
+		t.Errorf("%v.Value() = %v, %v; want %v, <nil>", d, got, err, want)
+	}
+}
+
+func TestScan(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		src     any
+		want    Date
+		wantErr bool
+	}{
+		{src: nil, want: Date{}},
+		{src: time.Date(2019, time.February, 6, 0, 0, 0, 0, time.UTC), want: NewDate(2019, time.February, 6)},
+		{src: time.Date(2019, time.February, 6, 23, 0, 0, 0, tokyo), want: NewDate(2019, time.February, 6)},
+		{src: "2019-02-06", want: NewDate(2019, time.February, 6)},
+		{src: []byte("2019-02-06"), want: NewDate(2019, time.February, 6)},
+		{src: "2/6/2019", want: NewDate(2019, time.February, 6)},
+		{src: "not a date", wantErr: true},
+		{src: []byte("2019-13-01"), wantErr: true},
+		{src: int64(20190206), wantErr: true},
+	}
+	for _, test := range tests {
+		got := NewDate(2000, time.January, 1)
+		err := got.Scan(test.src)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("Scan(%#v) = %v, <nil>; want _, <non-nil>", test.src, got)
+			}
+			continue
+		}
+		if got != test.want || err != nil {
+			t.Errorf("Scan(%#v) = %v, %v; want %v, <nil>", test.src, got, err, test.want)
+		}
+	}
+}
+
+func TestBreakdownUntil(t *testing.T) {
+	tests := []struct {
+		d, d2                      Date
+		years, months, weeks, days int
+	}{
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 6)},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 9), days: 3},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 22), weeks: 2, days: 2},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.March, 6), months: 1},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.March, 5), weeks: 3, days: 6},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2020, time.April, 20), years: 1, months: 2, weeks: 2},
+		{d: NewDate(2019, time.January, 31), d2: NewDate(2019, time.February, 28), months: 1},
+		{d: NewDate(2019, time.January, 31), d2: NewDate(2019, time.March, 1), months: 1, days: 1},
+		{d: NewDate(2019, time.January, 30), d2: NewDate(2019, time.March, 1), months: 1, days: 1},
+		{d: NewDate(2020, time.February, 29), d2: NewDate(2021, time.February, 28), years: 1},
+		{d: NewDate(2019, time.December, 25), d2: NewDate(2021, time.January, 10), years: 1, weeks: 2, days: 2},
+		{d: NewDate(2019, time.March, 6), d2: NewDate(2019, time.February, 6), months: -1},
+		{d: NewDate(2020, time.April, 20), d2: NewDate(2019, time.February, 6), years: -1, months: -2, weeks: -2},
+	}
+	for _, test := range tests {
+		years, months, weeks, days := test.d.BreakdownUntil(test.d2)
+		if years != test.years || months != test.months || weeks != test.weeks || days != test.days {
+			t.Errorf("%v.BreakdownUntil(%v) = %d, %d, %d, %d; want %d, %d, %d, %d",
+				test.d, test.d2, years, months, weeks, days,
+				test.years, test.months, test.weeks, test.days)
+		}
+		start, end := test.d, test.d2
+		if end.Before(start) {
+			start, end = end, start
+			years, months, weeks, days = -years, -months, -weeks, -days
+		}
+		if got := start.AddMonthsClamped(years*12+months).Add(0, 0, weeks*7+days); got != end {
+			t.Errorf("%v + %d years, %d months, %d weeks, %d days = %v; want %v",
+				start, years, months, weeks, days, got, end)
+		}
+	}
+}
+
+func TestAddMonthsClamped(t *testing.T) {
+	tests := []struct {
+		d      Date
+		months int
+		want   Date
+	}{
+		{d: NewDate(2020, time.January, 31), months: 1, want: NewDate(2020, time.February, 29)},
+		{d: NewDate(2019, time.January, 31), months: 1, want: NewDate(2019, time.February, 28)},
+		{d: NewDate(2019, time.January, 31), months: 2, want: NewDate(2019, time.March, 31)},
+		{d: NewDate(2019, time.January, 31), months: 3, want: NewDate(2019, time.April, 30)},
+		{d: NewDate(2019, time.May, 31), months: 1, want: NewDate(2019, time.June, 30)},
+		{d: NewDate(2019, time.August, 31), months: 1, want: NewDate(2019, time.September, 30)},
+		{d: NewDate(2019, time.October, 31), months: 1, want: NewDate(2019, time.November, 30)},
+		{d: NewDate(2019, time.October, 31), months: 4, want: NewDate(2020, time.February, 29)},
+		{d: NewDate(2019, time.March, 31), months: -1, want: NewDate(2019, time.February, 28)},
+		{d: NewDate(2020, time.February, 29), months: 12, want: NewDate(2021, time.February, 28)},
+		{d: NewDate(2019, time.February, 6), months: 0, want: NewDate(2019, time.February, 6)},
+		{d: NewDate(2019, time.February, 6), months: -14, want: NewDate(2017, time.December, 6)},
+	}
+	for _, test := range tests {
+		if got := test.d.AddMonthsClamped(test.months); got != test.want {
+			t.Errorf("%v.AddMonthsClamped(%d) = %v; want %v", test.d, test.months, got, test.want)
+		}
+	}
+}
+
+func TestWeeksAndDaysUntil(t *testing.T) {
+	tests := []struct {
+		d, d2     Date
+		wantWeeks int
+		wantDays  int
+	}{
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 6), wantWeeks: 0, wantDays: 0},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 13), wantWeeks: 1, wantDays: 0},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.March, 6), wantWeeks: 4, wantDays: 0},
+		{d: NewDate(2020, time.February, 6), d2: NewDate(2020, time.March, 6), wantWeeks: 4, wantDays: 1},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 8), wantWeeks: 0, wantDays: 2},
+		{d: NewDate(2019, time.December, 25), d2: NewDate(2020, time.January, 17), wantWeeks: 3, wantDays: 2},
+		{d: NewDate(2019, time.February, 13), d2: NewDate(2019, time.February, 6), wantWeeks: -1, wantDays: 0},
+		{d: NewDate(2020, time.January, 17), d2: NewDate(2019, time.December, 25), wantWeeks: -3, wantDays: -2},
+		{d: NewDate(2019, time.February, 8), d2: NewDate(2019, time.February, 6), wantWeeks: 0, wantDays: -2},
+	}
+	for _, test := range tests {
+		weeks, days := test.d.WeeksAndDaysUntil(test.d2)
+		if weeks != test.wantWeeks || days != test.wantDays {
+			t.Errorf("%v.WeeksAndDaysUntil(%v) = %d, %d; want %d, %d", test.d, test.d2, weeks, days, test.wantWeeks, test.wantDays)
+		}
+	}
+}
+
+func TestParseFractionalOrdinal(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(string) (Date, error)
+		s     string
+	}{
+		{name: "ParseDate", parse: ParseDate, s: "2019-037,5"},
+		{name: "ParseDate", parse: ParseDate, s: "2019-037.5"},
+		{name: "ParseOrdinalCompact", parse: ParseOrdinalCompact, s: "2019037,5"},
+		{name: "ParseOrdinalCompact", parse: ParseOrdinalCompact, s: "2019037.5"},
+	}
+	for _, test := range tests {
+		_, err := test.parse(test.s)
+		if err == nil || !strings.Contains(err.Error(), "fractional days not supported") {
+			t.Errorf("%s(%q) error = %v; want fractional days error", test.name, test.s, err)
+		}
+	}
+}
+
+func TestMonthBounds(t *testing.T) {
+	tests := []struct {
+		d       Date
+		wantEnd Date
+	}{
+		{d: NewDate(2019, time.January, 15), wantEnd: NewDate(2019, time.January, 31)},
+		{d: NewDate(2019, time.February, 15), wantEnd: NewDate(2019, time.February, 28)},
+		{d: NewDate(2019, time.March, 15), wantEnd: NewDate(2019, time.March, 31)},
+		{d: NewDate(2019, time.April, 15), wantEnd: NewDate(2019, time.April, 30)},
+		{d: NewDate(2019, time.May, 15), wantEnd: NewDate(2019, time.May, 31)},
+		{d: NewDate(2019, time.June, 15), wantEnd: NewDate(2019, time.June, 30)},
+		{d: NewDate(2019, time.July, 15), wantEnd: NewDate(2019, time.July, 31)},
+		{d: NewDate(2019, time.August, 15), wantEnd: NewDate(2019, time.August, 31)},
+		{d: NewDate(2019, time.September, 15), wantEnd: NewDate(2019, time.September, 30)},
+		{d: NewDate(2019, time.October, 15), wantEnd: NewDate(2019, time.October, 31)},
+		{d: NewDate(2019, time.November, 15), wantEnd: NewDate(2019, time.November, 30)},
+		{d: NewDate(2019, time.December, 15), wantEnd: NewDate(2019, time.December, 31)},
+		{d: NewDate(2020, time.February, 1), wantEnd: NewDate(2020, time.February, 29)},
+		{d: NewDate(2020, time.February, 29), wantEnd: NewDate(2020, time.February, 29)},
+	}
+	for _, test := range tests {
+		wantStart := NewDate(test.d.Year(), test.d.Month(), 1)
+		if got := test.d.StartOfMonth(); got != wantStart {
+			t.Errorf("%v.StartOfMonth() = %v; want %v", test.d, got, wantStart)
+		}
+		if got := test.d.EndOfMonth(); got != test.wantEnd {
+			t.Errorf("%v.EndOfMonth() = %v; want %v", test.d, got, test.wantEnd)
+		}
+		if got, want := test.d.StartOfYear(), NewDate(test.d.Year(), time.January, 1); got != want {
+			t.Errorf("%v.StartOfYear() = %v; want %v", test.d, got, want)
+		}
+		if got, want := test.d.EndOfYear(), NewDate(test.d.Year(), time.December, 31); got != want {
+			t.Errorf("%v.EndOfYear() = %v; want %v", test.d, got, want)
+		}
+	}
+}
+
+func TestYearBounds(t *testing.T) {
+	tests := []struct {
+		d         Date
+		wantStart Date
+		wantEnd   Date
+	}{
+		{d: NewDate(2019, time.June, 15), wantStart: NewDate(2019, time.January, 1), wantEnd: NewDate(2019, time.December, 31)},
+		{d: NewDate(2020, time.June, 15), wantStart: NewDate(2020, time.January, 1), wantEnd: NewDate(2020, time.December, 31)},
+		{d: NewDate(2020, time.February, 29), wantStart: NewDate(2020, time.January, 1), wantEnd: NewDate(2020, time.December, 31)},
+		{d: NewDate(2019, time.January, 1), wantStart: NewDate(2019, time.January, 1), wantEnd: NewDate(2019, time.December, 31)},
+		{d: NewDate(2019, time.December, 31), wantStart: NewDate(2019, time.January, 1), wantEnd: NewDate(2019, time.December, 31)},
+		{d: NewDate(-43, time.March, 15), wantStart: NewDate(-43, time.January, 1), wantEnd: NewDate(-43, time.December, 31)},
+	}
+	for _, test := range tests {
+		if got := test.d.StartOfYear(); got != test.wantStart {
+			t.Errorf("%v.StartOfYear() = %v; want %v", test.d, got, test.wantStart)
+		}
+		if got := test.d.EndOfYear(); got != test.wantEnd {
+			t.Errorf("%v.EndOfYear() = %v; want %v", test.d, got, test.wantEnd)
+		}
+		if got, want := test.d.EndOfYear().Sub(test.d.StartOfYear())+1, daysInYear(test.d.Year()); got != want {
+			t.Errorf("days in %d = %d; want %d", test.d.Year(), got, want)
+		}
+	}
+}
+
+func TestDecadeAndCentury(t *testing.T) {
+	tests := []struct {
+		d                Date
+		wantStartDecade  Date
+		wantEndDecade    Date
+		wantStartCentury Date
+		wantEndCentury   Date
+	}{
+		{
+			d:                NewDate(2019, time.February, 6),
+			wantStartDecade:  NewDate(2010, time.January, 1),
+			wantEndDecade:    NewDate(2019, time.December, 31),
+			wantStartCentury: NewDate(2000, time.January, 1),
+			wantEndCentury:   NewDate(2099, time.December, 31),
+		},
+		{
+			d:                NewDate(2010, time.January, 1),
+			wantStartDecade:  NewDate(2010, time.January, 1),
+			wantEndDecade:    NewDate(2019, time.December, 31),
+			wantStartCentury: NewDate(2000, time.January, 1),
+			wantEndCentury:   NewDate(2099, time.December, 31),
+		},
+		{
+			d:                NewDate(2000, time.January, 1),
+			wantStartDecade:  NewDate(2000, time.January, 1),
+			wantEndDecade:    NewDate(2009, time.December, 31),
+			wantStartCentury: NewDate(2000, time.January, 1),
+			wantEndCentury:   NewDate(2099, time.December, 31),
+		},
+		{
+			d:                NewDate(1999, time.December, 31),
+			wantStartDecade:  NewDate(1990, time.January, 1),
+			wantEndDecade:    NewDate(1999, time.December, 31),
+			wantStartCentury: NewDate(1900, time.January, 1),
+			wantEndCentury:   NewDate(1999, time.December, 31),
+		},
+		{
+			d:                NewDate(1955, time.June, 15),
+			wantStartDecade:  NewDate(1950, time.January, 1),
+			wantEndDecade:    NewDate(1959, time.December, 31),
+			wantStartCentury: NewDate(1900, time.January, 1),
+			wantEndCentury:   NewDate(1999, time.December, 31),
+		},
+		{
+			d:                NewDate(-5, time.June, 15),
+			wantStartDecade:  NewDate(-10, time.January, 1),
+			wantEndDecade:    NewDate(-1, time.December, 31),
+			wantStartCentury: NewDate(-100, time.January, 1),
+			wantEndCentury:   NewDate(-1, time.December, 31),
+		},
+	}
+	for _, test := range tests {
+		if got := test.d.StartOfDecade(); got != test.wantStartDecade {
+			t.Errorf("%v.StartOfDecade() = %v; want %v", test.d, got, test.wantStartDecade)
+		}
+		if got := test.d.EndOfDecade(); got != test.wantEndDecade {
+			t.Errorf("%v.EndOfDecade() = %v; want %v", test.d, got, test.wantEndDecade)
+		}
+		if got := test.d.StartOfCentury(); got != test.wantStartCentury {
+			t.Errorf("%v.StartOfCentury() = %v; want %v", test.d, got, test.wantStartCentury)
+		}
+		if got := test.d.EndOfCentury(); got != test.wantEndCentury {
+			t.Errorf("%v.EndOfCentury() = %v; want %v", test.d, got, test.wantEndCentury)
+		}
+	}
+}
+
+func TestNewDateRoundTrip(t *testing.T) {
+	const minYear, maxYear = -10000, 10000
+	start := daysFromCivil(minYear, time.January, 1)
+	end := daysFromCivil(maxYear, time.December, 31)
+	for n := start; n <= end; n += 13 {
+		d := civilFromDays(n)
+		got := NewDate(d.Year(), d.Month(), d.Day())
+		if got != d {
+			t.Fatalf("NewDate(%d, %v, %d) = %v; want %v", d.Year(), d.Month(), d.Day(), got, d)
+		}
+		year, month, day := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC).Date()
+		if year != d.Year() || month != d.Month() || day != d.Day() {
+			t.Fatalf("time.Date(%d, %v, %d, ...).Date() = %d, %v, %d", d.Year(), d.Month(), d.Day(), year, month, day)
+		}
+		if got := DateFromTime(d.ToTime(time.UTC)); got != d {
+			t.Fatalf("DateFromTime(%v.ToTime(time.UTC)) = %v", d, got)
+		}
+	}
+}
+
+func TestEqualMonth(t *testing.T) {
+	tests := []struct {
+		d, d2 Date
+		want  bool
+	}{
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 6), want: true},
+		{d: NewDate(2019, time.February, 1), d2: NewDate(2019, time.February, 28), want: true},
+		{d: NewDate(2019, time.February, 28), d2: NewDate(2019, time.March, 1), want: false},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2020, time.February, 6), want: false},
+	}
+	for _, test := range tests {
+		if got := test.d.EqualMonth(test.d2); got != test.want {
+			t.Errorf("%v.EqualMonth(%v) = %t; want %t", test.d, test.d2, got, test.want)
+		}
+	}
+}
+
+func TestRoundToMonth(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want Date
+	}{
+		{d: NewDate(2019, time.January, 1), want: NewDate(2019, time.January, 1)},
+		{d: NewDate(2019, time.January, 16), want: NewDate(2019, time.January, 1)},
+		{d: NewDate(2019, time.January, 17), want: NewDate(2019, time.February, 1)},
+		{d: NewDate(2019, time.April, 15), want: NewDate(2019, time.April, 1)},
+		{d: NewDate(2019, time.April, 16), want: NewDate(2019, time.May, 1)},
+		{d: NewDate(2019, time.February, 14), want: NewDate(2019, time.February, 1)},
+		{d: NewDate(2019, time.February, 15), want: NewDate(2019, time.March, 1)},
+		{d: NewDate(2020, time.February, 15), want: NewDate(2020, time.February, 1)},
+		{d: NewDate(2020, time.February, 16), want: NewDate(2020, time.March, 1)},
+		{d: NewDate(2019, time.December, 31), want: NewDate(2020, time.January, 1)},
+	}
+	for _, test := range tests {
+		if got := test.d.RoundToMonth(); got != test.want {
+			t.Errorf("%v.RoundToMonth() = %v; want %v", test.d, got, test.want)
+		}
+	}
+}
+
+func TestParseDatePivot(t *testing.T) {
+	tests := []struct {
+		s       string
+		pivot   int
+		want    Date
+		wantErr bool
+	}{
+		{s: "2/6/69", pivot: 2069, want: NewDate(2069, time.February, 6)},
+		{s: "2/6/70", pivot: 2069, want: NewDate(1970, time.February, 6)},
+		{s: "2/6/00", pivot: 2069, want: NewDate(2000, time.February, 6)},
+		{s: "2/6/99", pivot: 2069, want: NewDate(1999, time.February, 6)},
+		{s: "2/6/19", pivot: 2029, want: NewDate(2019, time.February, 6)},
+		{s: "2/6/30", pivot: 2029, want: NewDate(1930, time.February, 6)},
+		{s: "6.2.19", pivot: 2069, want: NewDate(2019, time.February, 6)},
+		{s: "2/6/2019", pivot: 2069, want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06", pivot: 2069, want: NewDate(2019, time.February, 6)},
+		{s: "13/6/19", pivot: 2069, wantErr: true},
+		{s: "2/6/9", pivot: 2069, wantErr: true},
+		{s: "2/6/1x", pivot: 2069, wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDatePivot(test.s, test.pivot)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseDatePivot(%q, %d) = %v, %v; want %v, %s", test.s, test.pivot, got, err, test.want, wantErr)
+		}
+	}
+
+	// ParseDate still rejects short years.
+	if got, err := ParseDate("2/6/19"); err == nil {
+		t.Errorf("ParseDate(\"2/6/19\") = %v, <nil>; want error", got)
+	}
+}
+
+func TestParseDatePrefix(t *testing.T) {
+	tests := []struct {
+		s        string
+		want     Date
+		wantRest string
+		wantErr  bool
+	}{
+		{s: "2019-02-06 and more text", want: NewDate(2019, time.February, 6), wantRest: " and more text"},
+		{s: "2019-02-06", want: NewDate(2019, time.February, 6), wantRest: ""},
+		{s: "2/6/2019: launch", want: NewDate(2019, time.February, 6), wantRest: ": launch"},
+		{s: "2019.02.06. Next", want: NewDate(2019, time.February, 6), wantRest: ". Next"},
+		{s: "2019-037T12:00", want: NewDate(2019, time.February, 6), wantRest: "T12:00"},
+		{s: "and more text", wantErr: true},
+		{s: " 2019-02-06", wantErr: true},
+		{s: "", wantErr: true},
+		{s: "--", wantErr: true},
+		{s: "2019-13-06 text", wantErr: true},
+		{s: "12345 text", wantErr: true},
+	}
+	for _, test := range tests {
+		got, rest, err := ParseDatePrefix(test.s)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseDatePrefix(%q) = %v, %q, <nil>; want error", test.s, got, rest)
+			}
+			continue
+		}
+		if got != test.want || rest != test.wantRest || err != nil {
+			t.Errorf("ParseDatePrefix(%q) = %v, %q, %v; want %v, %q, <nil>", test.s, got, rest, err, test.want, test.wantRest)
+		}
+	}
+}
+
+func TestParseDateLayout(t *testing.T) {
+	tests := []struct {
+		layout  string
+		value   string
+		want    Date
+		wantErr bool
+	}{
+		{layout: "02.01.2006", value: "06.02.2019", want: NewDate(2019, time.February, 6)},
+		{layout: "02/01/2006", value: "06/02/2019", want: NewDate(2019, time.February, 6)},
+		{layout: "2.1.2006", value: "6.2.2019", want: NewDate(2019, time.February, 6)},
+		{layout: "2 January 2006", value: "6 February 2019", want: NewDate(2019, time.February, 6)},
+		{layout: "Jan 2 2006", value: "Feb 6 2019", want: NewDate(2019, time.February, 6)},
+		{layout: "Monday, 2 Jan 2006", value: "Wednesday, 6 Feb 2019", want: NewDate(2019, time.February, 6)},
+		{layout: time.DateOnly, value: "2019-02-06", want: NewDate(2019, time.February, 6)},
+		{layout: "2006-01-02 15:04", value: "2019-02-06 00:00", want: NewDate(2019, time.February, 6)},
+		{layout: "2006-01-02-07:00", value: "2019-02-06+09:00", want: NewDate(2019, time.February, 6)},
+		{layout: "02.01.2006", value: "30.02.2019", wantErr: true},
+		{layout: "02.01.2006", value: "32.01.2019", wantErr: true},
+		{layout: "02.01.2006", value: "02/06/2019", wantErr: true},
+		{layout: "02.01.2006", value: "06.02.2019 12:00", wantErr: true},
+		{layout: "2006-01-02 15:04", value: "2019-02-06 12:30", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDateLayout(test.layout, test.value)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseDateLayout(%q, %q) = %v, %v; want %v, %s", test.layout, test.value, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestParseDateList(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    []Date
+		wantErr string
+	}{
+		{
+			s:    "2019-02-06, 2019-02-07",
+			want: []Date{NewDate(2019, time.February, 6), NewDate(2019, time.February, 7)},
+		},
+		{
+			s:    "2019-02-06;2/7/2019 ; 2019.02.08",
+			want: []Date{NewDate(2019, time.February, 6), NewDate(2019, time.February, 7), NewDate(2019, time.February, 8)},
+		},
+		{
+			s:    "2019-02-06",
+			want: []Date{NewDate(2019, time.February, 6)},
+		},
+		{s: "2019-02-06, bogus, 2019-02-08", wantErr: "item 1"},
+		{s: "2019-02-06,,2019-02-08", wantErr: "item 1"},
+		{s: "2019-02-06,", wantErr: "item 1"},
+		{s: "", wantErr: "empty list"},
+		{s: "  ", wantErr: "empty list"},
+	}
+	for _, test := range tests {
+		got, err := ParseDateList(test.s)
+		if test.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("ParseDateList(%q) = %v, %v; want error containing %q", test.s, got, err, test.wantErr)
+			}
+			continue
+		}
+		if err != nil || !slices.Equal(got, test.want) {
+			t.Errorf("ParseDateList(%q) = %v, %v; want %v, <nil>", test.s, got, err, test.want)
+		}
+	}
+}
+
+func TestParseFutureDate(t *testing.T) {
+	today := NewDate(2019, time.February, 6)
+	tests := []struct {
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{s: "2019-02-07", want: NewDate(2019, time.February, 7)},
+		{s: "2020-01-01", want: NewDate(2020, time.January, 1)},
+		{s: "2019-02-06", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-05", wantErr: true},
+		{s: "1/1/2019", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseFutureDate(test.s, today)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseFutureDate(%q, %v) = %v, %v; want %v, %s", test.s, today, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestNextLeapDay(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want Date
+	}{
+		{d: NewDate(2019, time.February, 6), want: NewDate(2020, time.February, 29)},
+		{d: NewDate(2020, time.January, 1), want: NewDate(2020, time.February, 29)},
+		{d: NewDate(2020, time.February, 29), want: NewDate(2020, time.February, 29)},
+		{d: NewDate(2020, time.March, 1), want: NewDate(2024, time.February, 29)},
+		{d: NewDate(2096, time.March, 1), want: NewDate(2104, time.February, 29)},
+		{d: NewDate(2100, time.February, 28), want: NewDate(2104, time.February, 29)},
+		{d: NewDate(1999, time.December, 31), want: NewDate(2000, time.February, 29)},
+	}
+	for _, test := range tests {
+		if got := test.d.NextLeapDay(); got != test.want {
+			t.Errorf("%v.NextLeapDay() = %v; want %v", test.d, got, test.want)
+		}
+	}
+}
+
+func TestLeapYear(t *testing.T) {
+	tests := []struct {
+		year int
+		want bool
+	}{
+		{year: 1600, want: true},
+		{year: 1700, want: false},
+		{year: 1900, want: false},
+		{year: 2000, want: true},
+		{year: 2019, want: false},
+		{year: 2020, want: true},
+		{year: 2100, want: false},
+		{year: 2400, want: true},
+		{year: 0, want: true},
+		{year: -1, want: false},
+		{year: -4, want: true},
+		{year: -100, want: false},
+		{year: -400, want: true},
+	}
+	for _, test := range tests {
+		if got := LeapYear(test.year); got != test.want {
+			t.Errorf("LeapYear(%d) = %t; want %t", test.year, got, test.want)
+		}
+		d := NewDate(test.year, time.June, 1)
+		if got := d.IsLeapYear(); got != test.want {
+			t.Errorf("%v.IsLeapYear() = %t; want %t", d, got, test.want)
+		}
+		// February 29 exists exactly in leap years.
+		if got := NewDate(test.year, time.February, 29).Month() == time.February; got != test.want {
+			t.Errorf("NewDate(%d, February, 29) in February = %t; want %t", test.year, got, test.want)
+		}
+	}
+}
+
+func TestYearDay(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want int
+	}{
+		{d: NewDate(2019, time.January, 1), want: 1},
+		{d: NewDate(2020, time.January, 1), want: 1},
+		{d: NewDate(2019, time.February, 6), want: 37},
+		{d: NewDate(2020, time.February, 29), want: 60},
+		{d: NewDate(2020, time.March, 1), want: 61},
+		{d: NewDate(2021, time.March, 1), want: 60},
+		{d: NewDate(2019, time.December, 31), want: 365},
+		{d: NewDate(2020, time.December, 31), want: 366},
+		{d: NewDate(1900, time.December, 31), want: 365},
+		{d: NewDate(0, time.December, 31), want: 366},
+	}
+	for _, test := range tests {
+		if got := test.d.YearDay(); got != test.want {
+			t.Errorf("%v.YearDay() = %d; want %d", test.d, got, test.want)
+		}
+	}
+
+	// Agree with the time package.
+	for d := NewDate(1999, time.January, 1); d.Year() < 2002; d = d.Add(0, 0, 1) {
+		tm := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+		if got, want := d.YearDay(), tm.YearDay(); got != want {
+			t.Errorf("%v.YearDay() = %d; want %d", d, got, want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		d      Date
+		layout string
+		want   string
+	}{
+		{d: NewDate(2019, time.February, 6), layout: "Jan 2, 2006", want: "Feb 6, 2019"},
+		{d: NewDate(2019, time.February, 6), layout: "02/01/2006", want: "06/02/2019"},
+		{d: NewDate(2019, time.February, 6), layout: "Monday, January 2, 2006", want: "Wednesday, February 6, 2019"},
+		{d: NewDate(2019, time.February, 6), layout: "Mon 2 Jan 06", want: "Wed 6 Feb 19"},
+		{d: NewDate(2019, time.February, 6), layout: "2006-002", want: "2019-037"},
+		{d: NewDate(2019, time.February, 6), layout: time.DateOnly, want: "2019-02-06"},
+		{d: NewDate(2019, time.February, 6), layout: "2006-01-02 15:04:05 MST", want: "2019-02-06 00:00:00 UTC"},
+		{d: NewDate(12, time.December, 25), layout: "Jan 2, 2006", want: "Dec 25, 0012"},
+	}
+	for _, test := range tests {
+		got := test.d.Format(test.layout)
+		if got != test.want {
+			t.Errorf("%v.Format(%q) = %q; want %q", test.d, test.layout, got, test.want)
+		}
+		tm := time.Date(test.d.Year(), test.d.Month(), test.d.Day(), 0, 0, 0, 0, time.UTC)
+		if want := tm.Format(test.layout); got != want {
+			t.Errorf("%v.Format(%q) = %q; time.Time.Format gives %q", test.d, test.layout, got, want)
+		}
+	}
+}
+
+func TestFormatParseDateLayoutRoundTrip(t *testing.T) {
+	layouts := []string{
+		time.DateOnly,
+		"01/02/2006",
+		"1/2/2006",
+		"02.01.2006",
+		"January 2, 2006",
+		"Monday, 2 Jan 2006",
+		"2006-002",
+		"20060102",
+	}
+	dates := []Date{
+		NewDate(0, time.January, 1),
+		NewDate(1, time.January, 1),
+		NewDate(1969, time.December, 31),
+		NewDate(2019, time.February, 6),
+		NewDate(2020, time.February, 29),
+		NewDate(9999, time.December, 31),
+	}
+	for _, layout := range layouts {
+		for _, d := range dates {
+			s := d.Format(layout)
+			if got, err := ParseDateLayout(layout, s); err != nil || got != d {
+				t.Errorf("ParseDateLayout(%q, %q) = %v, %v; want %v, <nil>", layout, s, got, err, d)
+			}
+		}
+	}
+
+	// Layouts that omit part of the date do not round-trip.
+	d := NewDate(2019, time.February, 6)
+	for _, layout := range []string{"January 2006", "Jan 2"} {
+		s := d.Format(layout)
+		if got, err := ParseDateLayout(layout, s); err == nil && got == d {
+			t.Errorf("ParseDateLayout(%q, %q) = %v, <nil>; want a different date", layout, s, got)
+		}
+	}
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want string
+	}{
+		{d: NewDate(2019, time.January, 1), want: "2019-001"},
+		{d: NewDate(2019, time.February, 6), want: "2019-037"},
+		{d: NewDate(2024, time.March, 1), want: "2024-061"},
+		{d: NewDate(2019, time.December, 31), want: "2019-365"},
+		{d: NewDate(2020, time.December, 31), want: "2020-366"},
+		{d: NewDate(900, time.April, 10), want: "0900-100"},
+		{d: NewDate(-753, time.April, 21), want: "-0753-111"},
+		{d: NewDate(10000, time.January, 1), want: "+10000-001"},
+	}
+	for _, test := range tests {
+		got := test.d.FormatOrdinal()
+		if got != test.want {
+			t.Errorf("%v.FormatOrdinal() = %q; want %q", test.d, got, test.want)
+		}
+		if parsed, err := ParseDate(got); err != nil || parsed != test.d {
+			t.Errorf("ParseDate(%q) = %v, %v; want %v, <nil>", got, parsed, err, test.d)
+		}
+	}
+}
+
+func TestDaysInMonth(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{year: 2021, month: time.January, want: 31},
+		{year: 2021, month: time.February, want: 28},
+		{year: 2021, month: time.March, want: 31},
+		{year: 2021, month: time.April, want: 30},
+		{year: 2021, month: time.May, want: 31},
+		{year: 2021, month: time.June, want: 30},
+		{year: 2021, month: time.July, want: 31},
+		{year: 2021, month: time.August, want: 31},
+		{year: 2021, month: time.September, want: 30},
+		{year: 2021, month: time.October, want: 31},
+		{year: 2021, month: time.November, want: 30},
+		{year: 2021, month: time.December, want: 31},
+		{year: 2020, month: time.February, want: 29},
+		{year: 2000, month: time.February, want: 29},
+		{year: 1900, month: time.February, want: 28},
+	}
+	for _, test := range tests {
+		if got := DaysInMonth(test.year, test.month); got != test.want {
+			t.Errorf("DaysInMonth(%d, %v) = %d; want %d", test.year, test.month, got, test.want)
+		}
+		d := NewDate(test.year, test.month, 1)
+		if got := d.DaysInMonth(); got != test.want {
+			t.Errorf("%v.DaysInMonth() = %d; want %d", d, got, test.want)
+		}
+	}
+
+	// Out of range months are normalized.
+	if got := DaysInMonth(2019, 14); got != 29 {
+		t.Errorf("DaysInMonth(2019, 14) = %d; want 29", got)
+	}
+	if got := DaysInMonth(2021, 0); got != 31 {
+		t.Errorf("DaysInMonth(2021, 0) = %d; want 31", got)
+	}
+}
+
+func TestIsLeapDay(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want bool
+	}{
+		{d: NewDate(2024, time.February, 29), want: true},
+		{d: NewDate(2024, time.February, 28), want: false},
+		{d: NewDate(2024, time.March, 1), want: false},
+		{d: NewDate(2023, time.February, 29), want: false}, // normalized to March 1
+		{d: NewDate(2024, time.June, 29), want: false},
+	}
+	for _, test := range tests {
+		if got := test.d.IsLeapDay(); got != test.want {
+			t.Errorf("%v.IsLeapDay() = %t; want %t", test.d, got, test.want)
+		}
+	}
+}
+
+func TestUnixNanoUTC(t *testing.T) {
+	tests := []Date{
+		NewDate(1970, time.January, 1),
+		NewDate(1969, time.December, 31),
+		NewDate(2019, time.February, 6),
+		NewDate(1677, time.September, 22),
+		NewDate(2262, time.April, 11),
+	}
+	for _, d := range tests {
+		want := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC).UnixNano()
+		if got := d.UnixNanoUTC(); got != want {
+			t.Errorf("%v.UnixNanoUTC() = %d; want %d", d, got, want)
+		}
+		if got, err := d.CheckedUnixNanoUTC(); got != want || err != nil {
+			t.Errorf("%v.CheckedUnixNanoUTC() = %d, %v; want %d, <nil>", d, got, err, want)
+		}
+	}
+	if got := NewDate(1970, time.January, 1).UnixNanoUTC(); got != 0 {
+		t.Errorf("1970-01-01.UnixNanoUTC() = %d; want 0", got)
+	}
+
+	for _, d := range []Date{NewDate(1677, time.September, 21), NewDate(2262, time.April, 12), {}} {
+		if got, err := d.CheckedUnixNanoUTC(); err == nil {
+			t.Errorf("%v.CheckedUnixNanoUTC() = %d, <nil>; want _, <non-nil>", d, got)
+		}
+	}
+}
+
+func TestParseDateAmbiguous(t *testing.T) {
+	tests := []struct {
+		s             string
+		want          Date
+		wantAmbiguous bool
+		wantErr       bool
+	}{
+		{s: "01/02/2019", want: NewDate(2019, time.January, 2), wantAmbiguous: true},
+		{s: "02/06/2019", want: NewDate(2019, time.February, 6), wantAmbiguous: true},
+		{s: "12/11/2019", want: NewDate(2019, time.December, 11), wantAmbiguous: true},
+		{s: "02/02/2019", want: NewDate(2019, time.February, 2), wantAmbiguous: false},
+		{s: "02/13/2019", want: NewDate(2019, time.February, 13), wantAmbiguous: false},
+		{s: "13/02/2019", want: NewDate(2019, time.February, 13), wantAmbiguous: false},
+		{s: "31/12/2019", want: NewDate(2019, time.December, 31), wantAmbiguous: false},
+		{s: "2019-02-06", want: NewDate(2019, time.February, 6), wantAmbiguous: false},
+		{s: "2019/02/06", want: NewDate(2019, time.February, 6), wantAmbiguous: false},
+		{s: "13/13/2019", wantErr: true},
+		{s: "32/12/2019", wantErr: true},
+		{s: "02/06/19", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, ambiguous, err := ParseDateAmbiguous(test.s)
+		if got != test.want || ambiguous != test.wantAmbiguous || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseDateAmbiguous(%q) = %v, %t, %v; want %v, %t, %s", test.s, got, ambiguous, err, test.want, test.wantAmbiguous, wantErr)
+		}
+	}
+}
+
+func TestFlag(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    Date
+		wantErr bool
+	}{
+		{args: nil, want: NewDate(2019, time.February, 6)},
+		{args: []string{"-date=2020-03-01"}, want: NewDate(2020, time.March, 1)},
+		{args: []string{"-date", "3/1/2020"}, want: NewDate(2020, time.March, 1)},
+		{args: []string{"-date=bork"}, wantErr: true},
+	}
+	for _, test := range tests {
+		fs := flag.NewFlagSet("gregorian", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		d := NewDate(2019, time.February, 6)
+		fs.Var(&d, "date", "a date")
+		if got, want := fs.Lookup("date").DefValue, "2019-02-06"; got != want {
+			t.Errorf("DefValue = %q; want %q", got, want)
+		}
+		err := fs.Parse(test.args)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("fs.Parse(%q) = <nil>; want error", test.args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("fs.Parse(%q): %v", test.args, err)
+			continue
+		}
+		if d != test.want {
+			t.Errorf("after fs.Parse(%q), date = %v; want %v", test.args, d, test.want)
+		}
+	}
+}
+
+func TestDaysUntilWeekday(t *testing.T) {
+	tests := []struct {
+		d    Date
+		w    time.Weekday
+		want int
+	}{
+		// 2019-02-06 is a Wednesday.
+		{d: NewDate(2019, time.February, 6), w: time.Wednesday, want: 0},
+		{d: NewDate(2019, time.February, 6), w: time.Thursday, want: 1},
+		{d: NewDate(2019, time.February, 6), w: time.Friday, want: 2},
+		{d: NewDate(2019, time.February, 6), w: time.Saturday, want: 3},
+		{d: NewDate(2019, time.February, 6), w: time.Sunday, want: 4},
+		{d: NewDate(2019, time.February, 6), w: time.Tuesday, want: 6},
+		{d: NewDate(2019, time.February, 9), w: time.Friday, want: 6},
+		{d: NewDate(2019, time.February, 10), w: time.Friday, want: 5},
+		{d: NewDate(2019, time.February, 8), w: time.Friday, want: 0},
+	}
+	for _, test := range tests {
+		if got := test.d.DaysUntilWeekday(test.w); got != test.want {
+			t.Errorf("%v.DaysUntilWeekday(%v) = %d; want %d", test.d, test.w, got, test.want)
+		}
+	}
+}
+
+func TestParseDateStrict(t *testing.T) {
+	tests := []struct {
+		s       string
+		level   Strictness
+		want    Date
+		wantErr bool
+	}{
+		{s: "2019-02-06", level: Lenient, want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06", level: ValidDay, want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06", level: Strict, want: NewDate(2019, time.February, 6)},
+
+		{s: "2019-2-6", level: Lenient, want: NewDate(2019, time.February, 6)},
+		{s: "2019-2-6", level: ValidDay, want: NewDate(2019, time.February, 6)},
+		{s: "2019-2-6", level: Strict, wantErr: true},
+
+		{s: "2/6/2019", level: Lenient, want: NewDate(2019, time.February, 6)},
+		{s: "2/6/2019", level: ValidDay, want: NewDate(2019, time.February, 6)},
+		{s: "2/6/2019", level: Strict, wantErr: true},
+		{s: " 2019-02-06", level: Strict, wantErr: true},
+
+		{s: "2019-02-30", level: Lenient, want: NewDate(2019, time.March, 2)},
+		{s: "2019-02-30", level: ValidDay, wantErr: true},
+		{s: "2019-02-30", level: Strict, wantErr: true},
+		{s: "2/29/2019", level: Lenient, want: NewDate(2019, time.March, 1)},
+		{s: "2/29/2019", level: ValidDay, wantErr: true},
+		{s: "2/29/2020", level: ValidDay, want: NewDate(2020, time.February, 29)},
+		{s: "31.04.2019", level: ValidDay, wantErr: true},
+		{s: "2020-02-29", level: Strict, want: NewDate(2020, time.February, 29)},
+		{s: "2019-04-31", level: Strict, wantErr: true},
+
+		{s: "2019-02-06", level: Strictness(42), wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDateStrict(test.s, test.level)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseDateStrict(%q, %d) = %v, %v; want %v, %s", test.s, test.level, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestCompareTime(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDate(2019, time.February, 6)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		d    Date
+		t    time.Time
+		want int
+	}{
+		{d: d, t: time.Date(2019, time.February, 6, 0, 0, 0, 0, tokyo), want: 0},
+		{d: d, t: time.Date(2019, time.February, 6, 0, 0, 0, 1, tokyo), want: -1},
+		{d: d, t: time.Date(2019, time.February, 5, 23, 59, 59, 999999999, tokyo), want: 1},
+		{d: d, t: time.Date(2019, time.February, 6, 12, 0, 0, 0, tokyo), want: -1},
+		// Midnight in Tokyo, expressed in UTC, is compared against midnight UTC.
+		{d: d, t: time.Date(2019, time.February, 5, 15, 0, 0, 0, time.UTC), want: 1},
+		{d: d, t: time.Date(2019, time.February, 6, 0, 0, 0, 0, time.UTC), want: 0},
+		// Midnight did not exist in Sao Paulo on 1990-10-21;
+		// the day started at 01:00.
+		{d: NewDate(1990, time.October, 21), t: NewDate(1990, time.October, 21).ToTime(saoPaulo), want: 0},
+		{d: NewDate(1990, time.October, 21), t: time.Date(1990, time.October, 20, 23, 30, 0, 0, saoPaulo), want: 1},
+	}
+	for _, test := range tests {
+		if got := test.d.CompareTime(test.t); got != test.want {
+			t.Errorf("%v.CompareTime(%v) = %d; want %d", test.d, test.t, got, test.want)
+		}
+	}
+}
+
+func TestToTime(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		d    Date
+		loc  *time.Location
+		want time.Time
+	}{
+		{
+			d:    NewDate(2019, time.February, 6),
+			loc:  nil,
+			want: time.Date(2019, time.February, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			d:    NewDate(2019, time.February, 6),
+			loc:  newYork,
+			want: time.Date(2019, time.February, 6, 0, 0, 0, 0, newYork),
+		},
+		{
+			// Clocks sprang forward at 02:00, so midnight exists.
+			d:    NewDate(2019, time.March, 10),
+			loc:  newYork,
+			want: time.Date(2019, time.March, 10, 0, 0, 0, 0, newYork),
+		},
+		{
+			// Clocks sprang forward from 00:00 to 01:00, so midnight does not exist.
+			d:    NewDate(2018, time.November, 4),
+			loc:  saoPaulo,
+			want: time.Date(2018, time.November, 4, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			// Clocks fell back from 00:00 to 23:00, so midnight happens once.
+			d:    NewDate(2019, time.February, 17),
+			loc:  saoPaulo,
+			want: time.Date(2019, time.February, 17, 3, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		got := test.d.ToTime(test.loc)
+		if !got.Equal(test.want) {
+			t.Errorf("%v.ToTime(%v) = %v; want %v", test.d, test.loc, got, test.want)
+		}
+		if !test.d.IsSameDay(got) {
+			t.Errorf("%v.ToTime(%v) = %v, which is not on %v", test.d, test.loc, got, test.d)
+		}
+	}
+}
+
+func TestDateFromTime(t *testing.T) {
+	instant := time.Date(2019, time.February, 6, 23, 30, 0, 0, time.UTC)
+	kiritimati := time.FixedZone("LINT", 14*60*60)
+	tests := []struct {
+		t    time.Time
+		want Date
+	}{
+		{t: instant, want: NewDate(2019, time.February, 6)},
+		{t: instant.In(kiritimati), want: NewDate(2019, time.February, 7)},
+		{t: time.Date(2019, time.February, 6, 0, 0, 0, 0, kiritimati), want: NewDate(2019, time.February, 6)},
+	}
+	for _, test := range tests {
+		if got := DateFromTime(test.t); !got.Equal(test.want) {
+			t.Errorf("DateFromTime(%v) = %v; want %v", test.t, got, test.want)
+		}
+	}
+
+	// Round trip through ToTime.
+	for _, d := range []Date{NewDate(2019, time.February, 6), NewDate(-1, time.December, 31)} {
+		for _, loc := range []*time.Location{time.UTC, kiritimati} {
+			if got := DateFromTime(d.ToTime(loc)); !got.Equal(d) {
+				t.Errorf("DateFromTime(%v.ToTime(%v)) = %v", d, loc, got)
+			}
+		}
+	}
+}
+
+func TestAtTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		d                    Date
+		hour, min, sec, nsec int
+		loc                  *time.Location
+		want                 time.Time
+	}{
+		{
+			d:    NewDate(2019, time.February, 6),
+			hour: 13, min: 30, sec: 15, nsec: 5,
+			loc:  nil,
+			want: time.Date(2019, time.February, 6, 13, 30, 15, 5, time.UTC),
+		},
+		{
+			d:    NewDate(2019, time.February, 6),
+			hour: 13, min: 30,
+			loc:  newYork,
+			want: time.Date(2019, time.February, 6, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			d:    NewDate(2019, time.February, 6),
+			hour: 24,
+			loc:  nil,
+			want: time.Date(2019, time.February, 7, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		got := test.d.AtTime(test.hour, test.min, test.sec, test.nsec, test.loc)
+		if !got.Equal(test.want) {
+			t.Errorf("%v.AtTime(%d, %d, %d, %d, %v) = %v; want %v",
+				test.d, test.hour, test.min, test.sec, test.nsec, test.loc, got, test.want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		d, d2 Date
+		want  int
+	}{
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 6), want: 0},
+		{d: Date{}, d2: Date{}, want: 0},
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 7), want: -1},
+		{d: NewDate(2019, time.February, 7), d2: NewDate(2019, time.February, 6), want: 1},
+		{d: NewDate(2019, time.January, 31), d2: NewDate(2019, time.February, 1), want: -1},
+		{d: NewDate(2019, time.December, 31), d2: NewDate(2020, time.January, 1), want: -1},
+		{d: NewDate(2020, time.January, 1), d2: NewDate(2019, time.December, 31), want: 1},
+	}
+	for _, test := range tests {
+		if got := test.d.Compare(test.d2); got != test.want {
+			t.Errorf("%v.Compare(%v) = %d; want %d", test.d, test.d2, got, test.want)
+		}
+		if got, want := test.d.After(test.d2), test.want > 0; got != want {
+			t.Errorf("%v.After(%v) = %t; want %t", test.d, test.d2, got, want)
+		}
+		if got, want := test.d.Before(test.d2), test.want < 0; got != want {
+			t.Errorf("%v.Before(%v) = %t; want %t", test.d, test.d2, got, want)
+		}
+	}
+
+	dates := []Date{
+		NewDate(2020, time.January, 1),
+		NewDate(2019, time.February, 7),
+		NewDate(2019, time.February, 6),
+	}
+	slices.SortFunc(dates, Date.Compare)
+	want := []Date{
+		NewDate(2019, time.February, 6),
+		NewDate(2019, time.February, 7),
+		NewDate(2020, time.January, 1),
+	}
+	if !slices.Equal(dates, want) {
+		t.Errorf("sorted dates = %v; want %v", dates, want)
+	}
+
+	if n := testing.AllocsPerRun(100, func() { want[0].Compare(want[1]) }); n != 0 {
+		t.Errorf("Compare allocated %v times; want 0", n)
+	}
+}
+
+func TestParseDateRejectsQuotes(t *testing.T) {
+	for _, s := range []string{`"2019-02-06"`, `'2019-02-06'`} {
+		if got, err := ParseDate(s); err == nil {
+			t.Errorf("ParseDate(%q) = %v, <nil>; want _, <non-nil>", s, got)
+		}
+	}
+}
+
+func TestClamp(t *testing.T) {
+	min := NewDate(2019, time.February, 1)
+	max := NewDate(2019, time.February, 28)
+	tests := []struct {
+		d, min, max Date
+		want        Date
+	}{
+		{d: NewDate(2019, time.January, 15), min: min, max: max, want: min},
+		{d: NewDate(2019, time.March, 15), min: min, max: max, want: max},
+		{d: NewDate(2019, time.February, 6), min: min, max: max, want: NewDate(2019, time.February, 6)},
+		{d: min, min: min, max: max, want: min},
+		{d: max, min: min, max: max, want: max},
+		{d: NewDate(2019, time.February, 6), min: min, max: min, want: min},
+		// min after max
+		{d: NewDate(2019, time.February, 6), min: max, max: min, want: max},
+		{d: NewDate(2019, time.March, 15), min: max, max: min, want: max},
+		{d: NewDate(2019, time.January, 15), min: max, max: min, want: max},
+	}
+	for _, test := range tests {
+		if got := test.d.Clamp(test.min, test.max); got != test.want {
+			t.Errorf("%v.Clamp(%v, %v) = %v; want %v", test.d, test.min, test.max, got, test.want)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		dates   []Date
+		wantMin Date
+		wantMax Date
+	}{
+		{dates: nil, wantMin: Date{}, wantMax: Date{}},
+		{
+			dates:   []Date{NewDate(2019, time.February, 6)},
+			wantMin: NewDate(2019, time.February, 6),
+			wantMax: NewDate(2019, time.February, 6),
+		},
+		{
+			dates: []Date{
+				NewDate(2019, time.February, 6),
+				NewDate(2018, time.December, 31),
+				NewDate(2020, time.January, 1),
+				NewDate(2019, time.March, 1),
+			},
+			wantMin: NewDate(2018, time.December, 31),
+			wantMax: NewDate(2020, time.January, 1),
+		},
+	}
+	for _, test := range tests {
+		if got := Min(test.dates...); got != test.wantMin {
+			t.Errorf("Min(%v) = %v; want %v", test.dates, got, test.wantMin)
+		}
+		if got := Max(test.dates...); got != test.wantMax {
+			t.Errorf("Max(%v) = %v; want %v", test.dates, got, test.wantMax)
+		}
+	}
+}
+
+func TestSub(t *testing.T) {
+	tests := []struct {
+		d, d2 Date
+		want  int
+	}{
+		{d: NewDate(2019, time.February, 6), d2: NewDate(2019, time.February, 6), want: 0},
+		{d: NewDate(2020, time.March, 1), d2: NewDate(2020, time.February, 28), want: 2},
+		{d: NewDate(2019, time.March, 1), d2: NewDate(2019, time.February, 28), want: 1},
+		{d: NewDate(2020, time.February, 28), d2: NewDate(2020, time.March, 1), want: -2},
+		{d: NewDate(1900, time.March, 1), d2: NewDate(1900, time.February, 28), want: 1},
+		{d: NewDate(2000, time.March, 1), d2: NewDate(2000, time.February, 28), want: 2},
+		{d: NewDate(1901, time.January, 1), d2: NewDate(1900, time.January, 1), want: 365},
+		{d: NewDate(2001, time.January, 1), d2: NewDate(2000, time.January, 1), want: 366},
+		{d: NewDate(2001, time.January, 1), d2: NewDate(1901, time.January, 1), want: 36525},
+		{d: NewDate(2000, time.January, 1), d2: NewDate(1900, time.January, 1), want: 36524},
+		{d: NewDate(1970, time.January, 1), d2: Date{}, want: 719162},
+	}
+	for _, test := range tests {
+		if got := test.d.Sub(test.d2); got != test.want {
+			t.Errorf("%v.Sub(%v) = %d; want %d", test.d, test.d2, got, test.want)
+		}
+	}
+}
+
+func TestAddDays(t *testing.T) {
+	tests := []struct {
+		d    Date
+		n    int
+		want Date
+	}{
+		{d: NewDate(2020, time.January, 31), n: 0, want: NewDate(2020, time.January, 31)},
+		{d: NewDate(2020, time.January, 31), n: 1, want: NewDate(2020, time.February, 1)},
+		{d: NewDate(2020, time.February, 28), n: 1, want: NewDate(2020, time.February, 29)},
+		{d: NewDate(2019, time.February, 28), n: 1, want: NewDate(2019, time.March, 1)},
+		{d: NewDate(2019, time.December, 31), n: 1, want: NewDate(2020, time.January, 1)},
+		{d: NewDate(2020, time.January, 1), n: -1, want: NewDate(2019, time.December, 31)},
+		{d: NewDate(2020, time.March, 1), n: -1, want: NewDate(2020, time.February, 29)},
+		{d: NewDate(2019, time.February, 6), n: 365, want: NewDate(2020, time.February, 6)},
+		{d: NewDate(2020, time.February, 6), n: 366, want: NewDate(2021, time.February, 6)},
+		{d: NewDate(2019, time.February, 6), n: -36525, want: NewDate(1919, time.February, 6)},
+		{d: NewDate(1, time.January, 1), n: -1, want: NewDate(0, time.December, 31)},
+	}
+	for _, test := range tests {
+		got := test.d.AddDays(test.n)
+		if got != test.want {
+			t.Errorf("%v.AddDays(%d) = %v; want %v", test.d, test.n, got, test.want)
+		}
+		if back := got.Sub(test.d); back != test.n {
+			t.Errorf("%v.Sub(%v) = %d; want %d", got, test.d, back, test.n)
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want int
+	}{
+		{d: NewDate(1, time.January, 1), want: 0},
+		{d: NewDate(1, time.January, 2), want: 1},
+		{d: NewDate(0, time.December, 31), want: -1},
+		{d: NewDate(1970, time.January, 1), want: 719162},
+		{d: NewDate(2019, time.February, 6), want: 737095},
+		{d: NewDate(9999, time.December, 31), want: 3652058},
+	}
+	for _, test := range tests {
+		if got := test.d.Ordinal(); got != test.want {
+			t.Errorf("%v.Ordinal() = %d; want %d", test.d, got, test.want)
+		}
+		if got := DateFromOrdinal(test.want); got != test.d {
+			t.Errorf("DateFromOrdinal(%d) = %v; want %v", test.want, got, test.d)
+		}
+	}
+
+	for n := -1000; n <= 3652058; n += 997 {
+		if got := DateFromOrdinal(n).Ordinal(); got != n {
+			t.Errorf("DateFromOrdinal(%d).Ordinal() = %d", n, got)
+		}
+	}
+}
+
+func TestWeekday(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want time.Weekday
+	}{
+		{d: NewDate(2024, time.January, 1), want: time.Monday},
+		{d: NewDate(1970, time.January, 1), want: time.Thursday},
+		{d: NewDate(2019, time.February, 6), want: time.Wednesday},
+		{d: NewDate(2000, time.February, 29), want: time.Tuesday},
+		{d: NewDate(1, time.January, 1), want: time.Monday},
+		{d: Date{}, want: time.Monday},
+		{d: NewDate(0, time.December, 31), want: time.Sunday},
+	}
+	for _, test := range tests {
+		if got := test.d.Weekday(); got != test.want {
+			t.Errorf("%v.Weekday() = %v; want %v", test.d, got, test.want)
+		}
+	}
+
+	d := NewDate(2024, time.January, 1)
+	if n := testing.AllocsPerRun(100, func() { d.Weekday() }); n != 0 {
+		t.Errorf("Weekday allocated %v times; want 0", n)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import "fmt"
+
+// DayCount is a day count convention used in financial calculations
+// to determine the fraction of a year between two dates.
+type DayCount int
+
+const (
+	// Actual365Fixed divides the actual number of days by 365,
+	// regardless of leap years.
+	Actual365Fixed DayCount = iota
+	// Actual360 divides the actual number of days by 360.
+	Actual360
+	// Thirty360 treats every month as having 30 days
+	// and every year as having 360 days.
+	// It follows the 30/360 Bond Basis rules (ISDA 2006 Section 4.16(f)):
+	// a start day of 31 becomes 30,
+	// and an end day of 31 becomes 30 if the start day is 30 or 31.
+	Thirty360
+)
+
+// DayCountFraction returns the fraction of a year from start to end
+// using the given day count convention.
+// The result is negative if end is before start.
+func DayCountFraction(start, end Date, convention DayCount) float64 {
+	switch convention {
+	case Actual365Fixed:
+		return float64(end.Sub(start)) / 365
+	case Actual360:
+		return float64(end.Sub(start)) / 360
+	case Thirty360:
+		d1, d2 := start.Day(), end.Day()
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+		days := 360*(end.Year()-start.Year()) + 30*int(end.Month()-start.Month()) + (d2 - d1)
+		return float64(days) / 360
+	default:
+		panic(fmt.Sprintf("gregorian.DayCountFraction: unknown convention %d", int(convention)))
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDayCountFraction(t *testing.T) {
+	tests := []struct {
+		start, end Date
+		convention DayCount
+		want       float64
+	}{
+		// January 15 to March 15, 2020 is 60 actual days across a leap February.
+		{start: NewDate(2020, time.January, 15), end: NewDate(2020, time.March, 15), convention: Actual365Fixed, want: 60.0 / 365},
+		{start: NewDate(2020, time.January, 15), end: NewDate(2020, time.March, 15), convention: Actual360, want: 60.0 / 360},
+		{start: NewDate(2020, time.January, 15), end: NewDate(2020, time.March, 15), convention: Thirty360, want: 60.0 / 360},
+		// The same span in a common year is 59 actual days.
+		{start: NewDate(2019, time.January, 15), end: NewDate(2019, time.March, 15), convention: Actual365Fixed, want: 59.0 / 365},
+		{start: NewDate(2019, time.January, 15), end: NewDate(2019, time.March, 15), convention: Thirty360, want: 60.0 / 360},
+		// A full leap year.
+		{start: NewDate(2020, time.January, 1), end: NewDate(2021, time.January, 1), convention: Actual365Fixed, want: 366.0 / 365},
+		{start: NewDate(2020, time.January, 1), end: NewDate(2021, time.January, 1), convention: Actual360, want: 366.0 / 360},
+		{start: NewDate(2020, time.January, 1), end: NewDate(2021, time.January, 1), convention: Thirty360, want: 1},
+		// Month-end adjustments.
+		{start: NewDate(2019, time.December, 31), end: NewDate(2020, time.March, 31), convention: Thirty360, want: 0.25},
+		{start: NewDate(2020, time.February, 29), end: NewDate(2020, time.March, 31), convention: Thirty360, want: 32.0 / 360},
+		{start: NewDate(2020, time.January, 31), end: NewDate(2020, time.February, 29), convention: Thirty360, want: 29.0 / 360},
+		{start: NewDate(2020, time.March, 15), end: NewDate(2020, time.January, 15), convention: Actual360, want: -60.0 / 360},
+	}
+	for _, test := range tests {
+		got := DayCountFraction(test.start, test.end, test.convention)
+		if math.Abs(got-test.want) > 1e-12 {
+			t.Errorf("DayCountFraction(%v, %v, %d) = %v; want %v", test.start, test.end, test.convention, got, test.want)
+		}
+	}
+}
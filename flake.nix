@@ -31,7 +31,7 @@
         devShells.default = pkgs.mkShell {
           packages = [
             pkgs.go-tools # staticcheck
-            pkgs.go_1_21
+            pkgs.go_1_23
             pkgs.gopls
           ];
         };
@@ -1,3 +1,3 @@
 module zombiezen.com/go/gregorian
 
-go 1.21.5
+go 1.23
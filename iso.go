@@ -0,0 +1,132 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Precision is the kind of ISO 8601 date recognized by [ParseISOAny].
+type Precision int
+
+const (
+	// PrecisionCalendarDate is a complete calendar date, like "2006-01-02".
+	PrecisionCalendarDate Precision = 1 + iota
+	// PrecisionOrdinalDate is a complete ordinal date, like "2006-002".
+	PrecisionOrdinalDate
+	// PrecisionWeekDate is a complete week date, like "2006-W01-1".
+	PrecisionWeekDate
+	// PrecisionWeek is a week without a day, like "2006-W01".
+	PrecisionWeek
+	// PrecisionMonth is a year and month without a day, like "2006-01".
+	PrecisionMonth
+	// PrecisionYear is a year alone, like "2006".
+	PrecisionYear
+)
+
+// String returns the name of the precision, like "PrecisionCalendarDate".
+func (p Precision) String() string {
+	switch p {
+	case PrecisionCalendarDate:
+		return "PrecisionCalendarDate"
+	case PrecisionOrdinalDate:
+		return "PrecisionOrdinalDate"
+	case PrecisionWeekDate:
+		return "PrecisionWeekDate"
+	case PrecisionWeek:
+		return "PrecisionWeek"
+	case PrecisionMonth:
+		return "PrecisionMonth"
+	case PrecisionYear:
+		return "PrecisionYear"
+	default:
+		return fmt.Sprintf("Precision(%d)", int(p))
+	}
+}
+
+// Reduced reports whether p is a reduced precision
+// that names a range of dates rather than a single date.
+func (p Precision) Reduced() bool {
+	return p == PrecisionWeek || p == PrecisionMonth || p == PrecisionYear
+}
+
+// ParseISOAny parses an ISO 8601 date in extended format at any precision
+// and reports which form it was in.
+// Complete dates (calendar, ordinal, or week dates) are returned as a Date
+// along with a range containing only that date.
+// Reduced precision dates (a week, a month, or a year)
+// are returned as the range of dates they cover and a zero Date.
+// Weeks are numbered as in [Date.ISOWeek].
+func ParseISOAny(s string) (Date, DateRange, Precision, error) {
+	s = strings.TrimSpace(s)
+	yearPart, rest, hasRest := strings.Cut(s, "-")
+	if len(yearPart) != 4 || !isDigits(yearPart) {
+		return Date{}, DateRange{}, 0, fmt.Errorf("parse ISO date %q: unknown format", s)
+	}
+	year, _ := strconv.Atoi(yearPart)
+
+	var r DateRange
+	var p Precision
+	switch {
+	case !hasRest:
+		p = PrecisionYear
+		r = DateRange{Start: NewDate(year, time.January, 1), End: NewDate(year, time.December, 31)}
+	case strings.HasPrefix(rest, "W"):
+		weekPart, dayPart, hasDay := strings.Cut(rest[1:], "-")
+		week, err := ParseWeekOfYear(yearPart+"-W"+weekPart, time.Monday)
+		if err != nil {
+			return Date{}, DateRange{}, 0, fmt.Errorf("parse ISO date %q: %v", s, err)
+		}
+		if !hasDay {
+			p, r = PrecisionWeek, week
+			break
+		}
+		if len(dayPart) != 1 || !('1' <= dayPart[0] && dayPart[0] <= '7') {
+			return Date{}, DateRange{}, 0, fmt.Errorf("parse ISO date %q: invalid day of week %q", s, dayPart)
+		}
+		d := week.Start.AddDays(int(dayPart[0] - '1'))
+		p, r = PrecisionWeekDate, DateRange{Start: d, End: d}
+	case len(rest) == 2 && isDigits(rest):
+		month, _ := strconv.Atoi(rest)
+		if !(1 <= month && month <= 12) {
+			return Date{}, DateRange{}, 0, fmt.Errorf("parse ISO date %q: invalid month %d", s, month)
+		}
+		ym := NewDate(year, time.Month(month), 1)
+		p, r = PrecisionMonth, DateRange{Start: ym, End: ym.EndOfMonth()}
+	case len(rest) == 3 && isDigits(rest):
+		d, err := parseISODate(s, true)
+		if err != nil {
+			return Date{}, DateRange{}, 0, err
+		}
+		p, r = PrecisionOrdinalDate, DateRange{Start: d, End: d}
+	case isFullDate(s):
+		d, err := parseISODate(s, true)
+		if err != nil {
+			return Date{}, DateRange{}, 0, err
+		}
+		p, r = PrecisionCalendarDate, DateRange{Start: d, End: d}
+	default:
+		return Date{}, DateRange{}, 0, fmt.Errorf("parse ISO date %q: unknown format", s)
+	}
+	if p.Reduced() {
+		return Date{}, r, p, nil
+	}
+	return r.Start, r, p, nil
+}
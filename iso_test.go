@@ -0,0 +1,101 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseISOAny(t *testing.T) {
+	tests := []struct {
+		s             string
+		want          Date
+		wantRange     DateRange
+		wantPrecision Precision
+		wantErr       bool
+	}{
+		{
+			s:             "2019-02-06",
+			want:          NewDate(2019, time.February, 6),
+			wantRange:     DateRange{NewDate(2019, time.February, 6), NewDate(2019, time.February, 6)},
+			wantPrecision: PrecisionCalendarDate,
+		},
+		{
+			s:             "2020-366",
+			want:          NewDate(2020, time.December, 31),
+			wantRange:     DateRange{NewDate(2020, time.December, 31), NewDate(2020, time.December, 31)},
+			wantPrecision: PrecisionOrdinalDate,
+		},
+		{
+			s:             "2019-W06-3",
+			want:          NewDate(2019, time.February, 6),
+			wantRange:     DateRange{NewDate(2019, time.February, 6), NewDate(2019, time.February, 6)},
+			wantPrecision: PrecisionWeekDate,
+		},
+		{
+			s:             "2020-W53-5",
+			want:          NewDate(2021, time.January, 1),
+			wantRange:     DateRange{NewDate(2021, time.January, 1), NewDate(2021, time.January, 1)},
+			wantPrecision: PrecisionWeekDate,
+		},
+		{
+			s:             "2019-W06",
+			wantRange:     DateRange{NewDate(2019, time.February, 4), NewDate(2019, time.February, 10)},
+			wantPrecision: PrecisionWeek,
+		},
+		{
+			s:             "2020-02",
+			wantRange:     DateRange{NewDate(2020, time.February, 1), NewDate(2020, time.February, 29)},
+			wantPrecision: PrecisionMonth,
+		},
+		{
+			s:             " 2019 ",
+			wantRange:     DateRange{NewDate(2019, time.January, 1), NewDate(2019, time.December, 31)},
+			wantPrecision: PrecisionYear,
+		},
+		{s: "", wantErr: true},
+		{s: "19", wantErr: true},
+		{s: "2019-", wantErr: true},
+		{s: "2019-13", wantErr: true},
+		{s: "2019-2", wantErr: true},
+		{s: "2019-366", wantErr: true},
+		{s: "2019-02-29", wantErr: true},
+		{s: "2019-2-6", wantErr: true},
+		{s: "2019-W54", wantErr: true},
+		{s: "2019-W06-0", wantErr: true},
+		{s: "2019-W06-8", wantErr: true},
+		{s: "2019-W06-", wantErr: true},
+		{s: "2/6/2019", wantErr: true},
+	}
+	for _, test := range tests {
+		got, gotRange, gotPrecision, err := ParseISOAny(test.s)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseISOAny(%q) = %v, %v, %v, <nil>; want error", test.s, got, gotRange, gotPrecision)
+			}
+			continue
+		}
+		if got != test.want || gotRange != test.wantRange || gotPrecision != test.wantPrecision || err != nil {
+			t.Errorf("ParseISOAny(%q) = %v, %v, %v, %v; want %v, %v, %v, <nil>",
+				test.s, got, gotRange, gotPrecision, err, test.want, test.wantRange, test.wantPrecision)
+		}
+		if got, want := gotPrecision.Reduced(), test.want.IsZero(); got != want {
+			t.Errorf("%v.Reduced() = %t; want %t", gotPrecision, got, want)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A japaneseEra is an era (gengō) of the Japanese calendar.
+type japaneseEra struct {
+	name      string // in kanji
+	romaji    string
+	abbrev    string
+	start     Date
+	firstYear int // Gregorian year of the era's first year
+}
+
+// japaneseEras is the list of Japanese eras since the adoption
+// of the Gregorian calendar in Japan, in chronological order.
+var japaneseEras = []japaneseEra{
+	// The Gregorian calendar was adopted on Meiji 6 (1873), January 1.
+	{name: "明治", romaji: "Meiji", abbrev: "M", start: NewDate(1873, time.January, 1), firstYear: 1868},
+	{name: "大正", romaji: "Taisho", abbrev: "T", start: NewDate(1912, time.July, 30), firstYear: 1912},
+	{name: "昭和", romaji: "Showa", abbrev: "S", start: NewDate(1926, time.December, 25), firstYear: 1926},
+	{name: "平成", romaji: "Heisei", abbrev: "H", start: NewDate(1989, time.January, 8), firstYear: 1989},
+	{name: "令和", romaji: "Reiwa", abbrev: "R", start: NewDate(2019, time.May, 1), firstYear: 2019},
+}
+
+// ParseJapaneseEra parses a date written with a Japanese era year,
+// either in kanji form (令和元年5月1日) or in abbreviated form (R1.5.1).
+// The era may be written in kanji, in romaji (Reiwa), or as its initial letter (R).
+// The abbreviated form may separate its components with '.', '/', or '-'.
+// ParseJapaneseEra returns an error if the date does not fall within the era.
+// Dates before Meiji 6 (1873), when Japan adopted the Gregorian calendar,
+// are not supported.
+func ParseJapaneseEra(s string) (Date, error) {
+	s = strings.TrimSpace(s)
+	era, rest, ok := cutJapaneseEra(s)
+	if !ok {
+		return Date{}, fmt.Errorf("parse Japanese era date %q: unknown era", s)
+	}
+	rest = strings.TrimLeft(rest, " ")
+
+	var eraYear int
+	if after, ok := strings.CutPrefix(rest, "元"); ok {
+		eraYear, rest = 1, after
+	} else if eraYear, rest, ok = cutNumber(rest); !ok {
+		return Date{}, fmt.Errorf("parse Japanese era date %q: missing year", s)
+	}
+	if eraYear < 1 {
+		return Date{}, fmt.Errorf("parse Japanese era date %q: invalid year %d", s, eraYear)
+	}
+
+	month, day, err := parseJapaneseMonthDay(rest)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse Japanese era date %q: %v", s, err)
+	}
+	year := era.firstYear + eraYear - 1
+	if !(1 <= month && month <= 12) {
+		return Date{}, fmt.Errorf("parse Japanese era date %q: invalid month %d", s, month)
+	}
+	if !(1 <= day && day <= DaysInMonth(year, time.Month(month))) {
+		return Date{}, fmt.Errorf("parse Japanese era date %q: invalid day %d", s, day)
+	}
+	d := NewDate(year, time.Month(month), day)
+	if d.Before(era.start) {
+		return Date{}, fmt.Errorf("parse Japanese era date %q: %v is before the start of %s", s, d, era.romaji)
+	}
+	if next := japaneseEraAfter(era); next != nil && !d.Before(next.start) {
+		return Date{}, fmt.Errorf("parse Japanese era date %q: %v is after the end of %s", s, d, era.romaji)
+	}
+	return d, nil
+}
+
+// FormatJapaneseEra returns d written with its Japanese era year in kanji form,
+// like "令和元年5月1日". The first year of an era is written as "元年".
+// Dates before Meiji 6 (1873), when Japan adopted the Gregorian calendar,
+// are returned in ISO 8601 format.
+func (d Date) FormatJapaneseEra() string {
+	var era *japaneseEra
+	for i := range japaneseEras {
+		if d.Before(japaneseEras[i].start) {
+			break
+		}
+		era = &japaneseEras[i]
+	}
+	if era == nil {
+		return d.String()
+	}
+	sb := new(strings.Builder)
+	sb.WriteString(era.name)
+	if eraYear := d.Year() - era.firstYear + 1; eraYear == 1 {
+		sb.WriteString("元")
+	} else {
+		sb.WriteString(strconv.Itoa(eraYear))
+	}
+	fmt.Fprintf(sb, "年%d月%d日", int(d.Month()), d.Day())
+	return sb.String()
+}
+
+// cutJapaneseEra removes the era name from the beginning of s.
+func cutJapaneseEra(s string) (_ *japaneseEra, rest string, ok bool) {
+	for i := range japaneseEras {
+		era := &japaneseEras[i]
+		if rest, ok := strings.CutPrefix(s, era.name); ok {
+			return era, rest, true
+		}
+		if len(s) >= len(era.romaji) && strings.EqualFold(s[:len(era.romaji)], era.romaji) {
+			return era, s[len(era.romaji):], true
+		}
+	}
+	for i := range japaneseEras {
+		era := &japaneseEras[i]
+		if len(s) >= len(era.abbrev) && strings.EqualFold(s[:len(era.abbrev)], era.abbrev) {
+			return era, s[len(era.abbrev):], true
+		}
+	}
+	return nil, s, false
+}
+
+// japaneseEraAfter returns the era following era or nil if era is the current era.
+func japaneseEraAfter(era *japaneseEra) *japaneseEra {
+	for i := range japaneseEras[:len(japaneseEras)-1] {
+		if &japaneseEras[i] == era {
+			return &japaneseEras[i+1]
+		}
+	}
+	return nil
+}
+
+// parseJapaneseMonthDay parses the month and day following an era year,
+// either "年1月2日" or a separator followed by "1.2".
+func parseJapaneseMonthDay(s string) (month, day int, err error) {
+	if rest, ok := strings.CutPrefix(s, "年"); ok {
+		var ok1, ok2 bool
+		month, rest, ok1 = cutNumber(rest)
+		rest, ok2 = strings.CutPrefix(rest, "月")
+		if !ok1 || !ok2 {
+			return 0, 0, errors.New("missing month")
+		}
+		day, rest, ok1 = cutNumber(rest)
+		rest, ok2 = strings.CutPrefix(rest, "日")
+		if !ok1 || !ok2 || rest != "" {
+			return 0, 0, errors.New("missing day")
+		}
+		return month, day, nil
+	}
+	if s == "" || !strings.ContainsRune("./-", rune(s[0])) {
+		return 0, 0, errors.New("unknown format")
+	}
+	parts := strings.Split(s[1:], s[:1])
+	if len(parts) != 2 || !isDigits(parts[0]) || !isDigits(parts[1]) {
+		return 0, 0, errors.New("unknown format")
+	}
+	month, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("month: %v", err)
+	}
+	day, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("day: %v", err)
+	}
+	return month, day, nil
+}
+
+// cutNumber parses the decimal digits at the beginning of s.
+func cutNumber(s string) (n int, rest string, ok bool) {
+	i := 0
+	for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return 0, s, false
+	}
+	n, err := strconv.Atoi(s[:i])
+	if err != nil {
+		return 0, s, false
+	}
+	return n, s[i:], true
+}
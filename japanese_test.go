@@ -0,0 +1,100 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseJapaneseEra(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{s: "令和元年5月1日", want: NewDate(2019, time.May, 1)},
+		{s: "令和1年5月1日", want: NewDate(2019, time.May, 1)},
+		{s: "R1.5.1", want: NewDate(2019, time.May, 1)},
+		{s: "r1/5/1", want: NewDate(2019, time.May, 1)},
+		{s: "Reiwa 1-5-1", want: NewDate(2019, time.May, 1)},
+		{s: "令和2年2月6日", want: NewDate(2020, time.February, 6)},
+		{s: "平成31年4月30日", want: NewDate(2019, time.April, 30)},
+		{s: "H31.4.30", want: NewDate(2019, time.April, 30)},
+		{s: "平成元年1月8日", want: NewDate(1989, time.January, 8)},
+		{s: "昭和64年1月7日", want: NewDate(1989, time.January, 7)},
+		{s: "S64.1.7", want: NewDate(1989, time.January, 7)},
+		{s: "Taisho 15.12.24", want: NewDate(1926, time.December, 24)},
+		{s: "明治6年1月1日", want: NewDate(1873, time.January, 1)},
+
+		{s: "平成31年5月1日", wantErr: true},
+		{s: "H32.1.1", wantErr: true},
+		{s: "令和元年4月30日", wantErr: true},
+		{s: "令和0年5月1日", wantErr: true},
+		{s: "R0.5.1", wantErr: true},
+		{s: "昭和64年1月8日", wantErr: true},
+		{s: "明治5年12月31日", wantErr: true},
+		{s: "R2.2.30", wantErr: true},
+		{s: "R2.13.1", wantErr: true},
+		{s: "R2.2", wantErr: true},
+		{s: "R2.2/6", wantErr: true},
+		{s: "令和2年2月6", wantErr: true},
+		{s: "令和2年2月6日です", wantErr: true},
+		{s: "X1.1.1", wantErr: true},
+		{s: "2019-05-01", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseJapaneseEra(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseJapaneseEra(%q) = %v, %v; want %v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestFormatJapaneseEra(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want string
+	}{
+		{d: NewDate(2019, time.April, 30), want: "平成31年4月30日"},
+		{d: NewDate(2019, time.May, 1), want: "令和元年5月1日"},
+		{d: NewDate(2019, time.December, 31), want: "令和元年12月31日"},
+		{d: NewDate(2020, time.February, 6), want: "令和2年2月6日"},
+		{d: NewDate(1989, time.January, 7), want: "昭和64年1月7日"},
+		{d: NewDate(1989, time.January, 8), want: "平成元年1月8日"},
+		{d: NewDate(1926, time.December, 25), want: "昭和元年12月25日"},
+		{d: NewDate(1873, time.January, 1), want: "明治6年1月1日"},
+		{d: NewDate(1872, time.December, 31), want: "1872-12-31"},
+	}
+	for _, test := range tests {
+		got := test.d.FormatJapaneseEra()
+		if got != test.want {
+			t.Errorf("%v.FormatJapaneseEra() = %q; want %q", test.d, got, test.want)
+		}
+		if test.d.Before(japaneseEras[0].start) {
+			continue
+		}
+		if parsed, err := ParseJapaneseEra(got); parsed != test.d || err != nil {
+			t.Errorf("ParseJapaneseEra(%q) = %v, %v; want %v, <nil>", got, parsed, err, test.d)
+		}
+	}
+}
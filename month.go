@@ -0,0 +1,88 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import "time"
+
+// NthWeekdayInMonth returns the nth occurrence of the weekday w
+// in the given month, like the first Monday of September.
+// If n is negative, NthWeekdayInMonth counts backward from the end of the month,
+// so -1 is the last occurrence.
+// NthWeekdayInMonth returns false if the month has no such occurrence
+// or n is zero.
+func NthWeekdayInMonth(year int, month time.Month, w time.Weekday, n int) (Date, bool) {
+	days := DaysInMonth(year, month)
+	var day int
+	switch {
+	case n > 0:
+		first := NewDate(year, month, 1).Weekday()
+		day = 1 + int(w-first+7)%7 + 7*(n-1)
+	case n < 0:
+		last := NewDate(year, month, days).Weekday()
+		day = days - int(last-w+7)%7 - 7*(-n-1)
+	default:
+		return Date{}, false
+	}
+	if !(1 <= day && day <= days) {
+		return Date{}, false
+	}
+	return NewDate(year, month, day), true
+}
+
+// WeekdayMask returns a bitmask of the weekend days in the given month
+// for cheaply laying out calendar grids.
+// Bit i is set if day i+1 of the month is a Saturday or Sunday.
+func WeekdayMask(year int, month time.Month) uint32 {
+	w, days := MonthInfo(year, month)
+	var mask uint32
+	for i := 0; i < days; i++ {
+		if w == time.Saturday || w == time.Sunday {
+			mask |= 1 << i
+		}
+		w = (w + 1) % 7
+	}
+	return mask
+}
+
+// MonthInfo returns the day of the week of the first day of the given month
+// and the number of days in the month.
+func MonthInfo(year int, month time.Month) (firstWeekday time.Weekday, days int) {
+	first := NewDate(year, month, 1)
+	return first.Weekday(), DaysInMonth(first.Year(), first.Month())
+}
+
+// CountDayOfMonth returns the number of dates in the inclusive range
+// [start, end] whose day of the month is dayOfMonth.
+// Months that do not have that day, like February for the 30th, are not counted.
+func CountDayOfMonth(start, end Date, dayOfMonth int) int {
+	if !(1 <= dayOfMonth && dayOfMonth <= 31) {
+		return 0
+	}
+	n := 0
+	for ym := (YearMonth{Year: start.Year(), Month: start.Month()}); ; ym = ym.addMonths(1) {
+		if ym.Year > end.Year() || ym.Year == end.Year() && ym.Month > end.Month() {
+			return n
+		}
+		if dayOfMonth > DaysInMonth(ym.Year, ym.Month) {
+			continue
+		}
+		d := NewDate(ym.Year, ym.Month, dayOfMonth)
+		if !d.Before(start) && !end.Before(d) {
+			n++
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNthWeekdayInMonth(t *testing.T) {
+	tests := []struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		want    Date
+		wantOK  bool
+	}{
+		// Labor Day
+		{year: 2019, month: time.September, weekday: time.Monday, n: 1, want: NewDate(2019, time.September, 2), wantOK: true},
+		{year: 2020, month: time.September, weekday: time.Monday, n: 1, want: NewDate(2020, time.September, 7), wantOK: true},
+		// Memorial Day
+		{year: 2019, month: time.May, weekday: time.Monday, n: -1, want: NewDate(2019, time.May, 27), wantOK: true},
+		{year: 2021, month: time.May, weekday: time.Monday, n: -1, want: NewDate(2021, time.May, 31), wantOK: true},
+		// Thanksgiving
+		{year: 2019, month: time.November, weekday: time.Thursday, n: 4, want: NewDate(2019, time.November, 28), wantOK: true},
+		{year: 2019, month: time.February, weekday: time.Friday, n: 1, want: NewDate(2019, time.February, 1), wantOK: true},
+		{year: 2019, month: time.February, weekday: time.Thursday, n: 4, want: NewDate(2019, time.February, 28), wantOK: true},
+		{year: 2019, month: time.February, weekday: time.Friday, n: -4, want: NewDate(2019, time.February, 1), wantOK: true},
+		{year: 2020, month: time.February, weekday: time.Saturday, n: 5, want: NewDate(2020, time.February, 29), wantOK: true},
+		{year: 2019, month: time.February, weekday: time.Friday, n: 5, wantOK: false},
+		{year: 2019, month: time.September, weekday: time.Monday, n: 5, want: NewDate(2019, time.September, 30), wantOK: true},
+		{year: 2020, month: time.September, weekday: time.Monday, n: 5, wantOK: false},
+		{year: 2019, month: time.February, weekday: time.Friday, n: -5, wantOK: false},
+		{year: 2019, month: time.September, weekday: time.Monday, n: 0, wantOK: false},
+	}
+	for _, test := range tests {
+		got, ok := NthWeekdayInMonth(test.year, test.month, test.weekday, test.n)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("NthWeekdayInMonth(%d, %v, %v, %d) = %v, %t; want %v, %t", test.year, test.month, test.weekday, test.n, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func TestWeekdayMask(t *testing.T) {
+	tests := []struct {
+		year         int
+		month        time.Month
+		wantWeekends []int
+	}{
+		{year: 2019, month: time.February, wantWeekends: []int{2, 3, 9, 10, 16, 17, 23, 24}},
+		{year: 2020, month: time.February, wantWeekends: []int{1, 2, 8, 9, 15, 16, 22, 23, 29}},
+		{year: 2019, month: time.March, wantWeekends: []int{2, 3, 9, 10, 16, 17, 23, 24, 30, 31}},
+		{year: 2020, month: time.August, wantWeekends: []int{1, 2, 8, 9, 15, 16, 22, 23, 29, 30}},
+	}
+	for _, test := range tests {
+		mask := WeekdayMask(test.year, test.month)
+		var got []int
+		for i := 0; i < 32; i++ {
+			if mask&(1<<i) != 0 {
+				got = append(got, i+1)
+			}
+		}
+		if !slices.Equal(got, test.wantWeekends) {
+			t.Errorf("WeekdayMask(%d, %v) = %#x (days %v); want days %v", test.year, test.month, mask, got, test.wantWeekends)
+		}
+	}
+}
+
+func TestMonthInfo(t *testing.T) {
+	tests := []struct {
+		year             int
+		month            time.Month
+		wantFirstWeekday time.Weekday
+		wantDays         int
+	}{
+		{year: 2019, month: time.February, wantFirstWeekday: time.Friday, wantDays: 28},
+		{year: 2020, month: time.February, wantFirstWeekday: time.Saturday, wantDays: 29},
+		{year: 2000, month: time.February, wantFirstWeekday: time.Tuesday, wantDays: 29},
+		{year: 1900, month: time.February, wantFirstWeekday: time.Thursday, wantDays: 28},
+		{year: 2019, month: time.September, wantFirstWeekday: time.Sunday, wantDays: 30},
+		{year: 2024, month: time.January, wantFirstWeekday: time.Monday, wantDays: 31},
+		{year: 2019, month: time.October, wantFirstWeekday: time.Tuesday, wantDays: 31},
+	}
+	for _, test := range tests {
+		firstWeekday, days := MonthInfo(test.year, test.month)
+		if firstWeekday != test.wantFirstWeekday || days != test.wantDays {
+			t.Errorf("MonthInfo(%d, %v) = %v, %d; want %v, %d", test.year, test.month, firstWeekday, days, test.wantFirstWeekday, test.wantDays)
+		}
+	}
+}
+
+func TestCountDayOfMonth(t *testing.T) {
+	tests := []struct {
+		start, end Date
+		dayOfMonth int
+		want       int
+	}{
+		{
+			start:      NewDate(2019, time.January, 1),
+			end:        NewDate(2019, time.December, 31),
+			dayOfMonth: 15,
+			want:       12,
+		},
+		{
+			start:      NewDate(2019, time.January, 1),
+			end:        NewDate(2019, time.December, 31),
+			dayOfMonth: 31,
+			want:       7,
+		},
+		{
+			// January 31 is excluded by the start date.
+			start:      NewDate(2019, time.February, 1),
+			end:        NewDate(2019, time.June, 30),
+			dayOfMonth: 31,
+			want:       2,
+		},
+		{
+			start:      NewDate(2019, time.January, 1),
+			end:        NewDate(2019, time.December, 31),
+			dayOfMonth: 29,
+			want:       11,
+		},
+		{
+			start:      NewDate(2020, time.January, 1),
+			end:        NewDate(2020, time.December, 31),
+			dayOfMonth: 29,
+			want:       12,
+		},
+		{
+			start:      NewDate(2019, time.January, 29),
+			end:        NewDate(2019, time.March, 28),
+			dayOfMonth: 29,
+			want:       1,
+		},
+		{
+			start:      NewDate(2019, time.January, 30),
+			end:        NewDate(2019, time.March, 29),
+			dayOfMonth: 29,
+			want:       1,
+		},
+		{
+			start:      NewDate(2019, time.February, 6),
+			end:        NewDate(2019, time.February, 6),
+			dayOfMonth: 6,
+			want:       1,
+		},
+		{
+			start:      NewDate(2019, time.February, 7),
+			end:        NewDate(2019, time.February, 6),
+			dayOfMonth: 6,
+			want:       0,
+		},
+		{
+			start:      NewDate(2019, time.January, 1),
+			end:        NewDate(2019, time.December, 31),
+			dayOfMonth: 32,
+			want:       0,
+		},
+		{
+			start:      NewDate(2019, time.January, 1),
+			end:        NewDate(2019, time.December, 31),
+			dayOfMonth: 0,
+			want:       0,
+		},
+	}
+	for _, test := range tests {
+		got := CountDayOfMonth(test.start, test.end, test.dayOfMonth)
+		if got != test.want {
+			t.Errorf("CountDayOfMonth(%v, %v, %d) = %d; want %d", test.start, test.end, test.dayOfMonth, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DayOrder is the order of the year, month, and day in a numeric date.
+type DayOrder int
+
+const (
+	// MDY is month, day, year order, as in the United States (2/6/2019).
+	MDY DayOrder = iota
+	// DMY is day, month, year order, as in most of Europe (6.2.2019).
+	DMY
+	// YMD is year, month, day order, as in ISO 8601 (2019-02-06).
+	YMD
+)
+
+// defaultSeparators is the set of separators used by a [Parser]
+// with no Separators.
+const defaultSeparators = "-/."
+
+// A Parser parses dates in a configurable format.
+// The zero value parses U.S.-ordered dates like "2/6/2019" or "2-6-2019".
+type Parser struct {
+	// Order is the order of the components of a numeric date.
+	Order DayOrder
+	// Separators is the set of characters permitted between the components
+	// of a numeric date. The same separator must be used throughout a date.
+	// If empty, then '-', '/', and '.' are permitted.
+	Separators []byte
+	// MonthNames permits dates with English month names
+	// in the forms accepted by [ParseDateTolerant],
+	// like "February 6, 2019" or "6 Feb 2019".
+	MonthNames bool
+	// Pivot, if non-zero, permits two-digit years.
+	// A two-digit year is interpreted as the latest year
+	// no later than Pivot with the same last two digits,
+	// so a Pivot of 2069 maps "70" to 1970 and "69" to 2069.
+	// If zero, then years must have at least three digits.
+	Pivot int
+	// TwoDigitYears, if not nil, permits two-digit years
+	// and determines their century. It takes precedence over Pivot.
+	TwoDigitYears *TwoDigitYearPolicy
+}
+
+// A TwoDigitYearPolicy determines the century of a two-digit year.
+// Centuries are given as the leading digits of the year,
+// so a century of 20 selects years 2000 through 2099.
+type TwoDigitYearPolicy struct {
+	// Pivot is the smallest two-digit year that is placed in HighCentury.
+	// Two-digit years less than Pivot are placed in LowCentury.
+	Pivot int
+	// LowCentury is the century of two-digit years less than Pivot.
+	LowCentury int
+	// HighCentury is the century of two-digit years greater than or equal to Pivot.
+	HighCentury int
+}
+
+// Year returns the full year for the two-digit year yy,
+// which must be in the range [0,99].
+// For example, with a policy of {Pivot: 50, LowCentury: 20, HighCentury: 19},
+// 49 is 2049 and 50 is 1950.
+func (policy TwoDigitYearPolicy) Year(yy int) int {
+	if yy < policy.Pivot {
+		return policy.LowCentury*100 + yy
+	}
+	return policy.HighCentury*100 + yy
+}
+
+// Parse parses a date in the format described by p.
+// Days that do not exist in the month are rejected.
+func (p Parser) Parse(s string) (Date, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Date{}, fmt.Errorf("parse date %q: empty date", s)
+	}
+	if hasLetter(s) {
+		if !p.MonthNames {
+			return Date{}, fmt.Errorf("parse date %q: month names not permitted", s)
+		}
+		yearPart, month, day, err := splitMonthNameDate(s)
+		if err != nil {
+			return Date{}, err
+		}
+		year, err := p.parseYear(s, yearPart)
+		if err != nil {
+			return Date{}, err
+		}
+		if day > DaysInMonth(year, month) {
+			return Date{}, fmt.Errorf("parse date %q: invalid day %d", s, day)
+		}
+		return NewDate(year, month, day), nil
+	}
+
+	i := strings.IndexFunc(s, func(c rune) bool { return !('0' <= c && c <= '9') })
+	if i < 0 {
+		return Date{}, fmt.Errorf("parse date %q: unknown format", s)
+	}
+	sep := s[i]
+	if !p.permitsSeparator(sep) {
+		return Date{}, fmt.Errorf("parse date %q: separator %q not permitted", s, sep)
+	}
+	// Split by hand to avoid allocating a slice.
+	first, rest := s[:i], s[i+1:]
+	second, third, ok := strings.Cut(rest, s[i:i+1])
+	if !ok || strings.IndexByte(third, sep) >= 0 {
+		return Date{}, fmt.Errorf("parse date %q: unknown format", s)
+	}
+	var yearPart, monthPart, dayPart string
+	switch p.Order {
+	case MDY:
+		monthPart, dayPart, yearPart = first, second, third
+	case DMY:
+		dayPart, monthPart, yearPart = first, second, third
+	case YMD:
+		yearPart, monthPart, dayPart = first, second, third
+	default:
+		return Date{}, fmt.Errorf("parse date %q: unknown day order %d", s, int(p.Order))
+	}
+
+	year, err := p.parseYear(s, yearPart)
+	if err != nil {
+		return Date{}, err
+	}
+	month, err := strconv.Atoi(monthPart)
+	if err != nil || !isDigits(monthPart) {
+		return Date{}, fmt.Errorf("parse date %q: invalid month %q", s, monthPart)
+	}
+	if !(1 <= month && month <= 12) {
+		return Date{}, fmt.Errorf("parse date %q: invalid month %d", s, month)
+	}
+	day, err := strconv.Atoi(dayPart)
+	if err != nil || !isDigits(dayPart) {
+		return Date{}, fmt.Errorf("parse date %q: invalid day %q", s, dayPart)
+	}
+	if !(1 <= day && day <= DaysInMonth(year, time.Month(month))) {
+		return Date{}, fmt.Errorf("parse date %q: invalid day %d", s, day)
+	}
+	return NewDate(year, time.Month(month), day), nil
+}
+
+// parseYear parses the year component of the date s,
+// resolving a two-digit year according to p.
+func (p Parser) parseYear(s, yearPart string) (int, error) {
+	if !isDigits(yearPart) {
+		return 0, fmt.Errorf("parse date %q: invalid year %q", s, yearPart)
+	}
+	year, err := strconv.Atoi(yearPart)
+	if err != nil {
+		return 0, fmt.Errorf("parse date %q: year: %v", s, err)
+	}
+	switch {
+	case len(yearPart) == 2 && p.TwoDigitYears != nil:
+		year = p.TwoDigitYears.Year(year)
+	case len(yearPart) == 2 && p.Pivot != 0:
+		year = p.Pivot - floorMod(p.Pivot-year, 100)
+	case len(yearPart) <= 2:
+		return 0, fmt.Errorf("parse date %q: short years not allowed", s)
+	}
+	return year, nil
+}
+
+// ParseDateInLocale parses a numeric date whose components are in the given order,
+// like "06/02/2019", which is June 2 in [MDY] order and February 6 in [DMY] order.
+// Components may be separated by '-', '/', or '.'.
+// A date that begins with a four-digit year, like "2019-02-06",
+// is always read in [YMD] order.
+// Days that do not exist in the month are rejected.
+func ParseDateInLocale(s string, order DayOrder) (Date, error) {
+	p := Parser{Order: order}
+	if i := strings.IndexAny(s, defaultSeparators); i >= 0 {
+		if first := strings.TrimSpace(s[:i]); len(first) == 4 && isDigits(first) {
+			p.Order = YMD
+		}
+	}
+	return p.Parse(s)
+}
+
+// ParseAll parses each string in ss with [Parser.Parse].
+// The returned slice of dates has the same length as ss.
+// If any string fails to parse, then the returned slice of errors
+// also has the same length as ss, with nil entries for the strings that parsed.
+// Otherwise, the returned slice of errors is nil.
+func (p Parser) ParseAll(ss []string) ([]Date, []error) {
+	dates := make([]Date, len(ss))
+	var errs []error
+	for i, s := range ss {
+		var err error
+		dates[i], err = p.Parse(s)
+		if err != nil {
+			if errs == nil {
+				errs = make([]error, len(ss))
+			}
+			errs[i] = err
+		}
+	}
+	return dates, errs
+}
+
+func (p Parser) permitsSeparator(c byte) bool {
+	if len(p.Separators) == 0 {
+		return strings.IndexByte(defaultSeparators, c) >= 0
+	}
+	return bytes.IndexByte(p.Separators, c) >= 0
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParser(t *testing.T) {
+	european := Parser{Order: DMY, Separators: []byte{'.'}}
+	tests := []struct {
+		p       Parser
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{p: european, s: "06.02.2019", want: NewDate(2019, time.February, 6)},
+		{p: european, s: "6.2.2019", want: NewDate(2019, time.February, 6)},
+		{p: european, s: " 29.02.2020 ", want: NewDate(2020, time.February, 29)},
+		{p: european, s: "02.25.2019", wantErr: true},
+		{p: european, s: "02/06/2019", wantErr: true},
+		{p: european, s: "06.02.19", wantErr: true},
+		{p: european, s: "29.02.2019", wantErr: true},
+		{p: european, s: "06.02-2019", wantErr: true},
+		{p: european, s: "06.02", wantErr: true},
+		{p: european, s: "6 Feb 2019", wantErr: true},
+		{p: european, s: "", wantErr: true},
+
+		{p: Parser{}, s: "2/6/2019", want: NewDate(2019, time.February, 6)},
+		{p: Parser{}, s: "2-6-2019", want: NewDate(2019, time.February, 6)},
+		{p: Parser{}, s: "2.6.2019", want: NewDate(2019, time.February, 6)},
+		{p: Parser{}, s: "2_6_2019", wantErr: true},
+		{p: Parser{}, s: "20190206", wantErr: true},
+		{p: Parser{}, s: "+2/6/2019", wantErr: true},
+
+		{p: Parser{Order: YMD}, s: "2019-02-06", want: NewDate(2019, time.February, 6)},
+		{p: Parser{Order: YMD}, s: "2019/2/6", want: NewDate(2019, time.February, 6)},
+		{p: Parser{Order: DayOrder(42)}, s: "2019-02-06", wantErr: true},
+
+		{p: Parser{Pivot: 2069}, s: "2/6/69", want: NewDate(2069, time.February, 6)},
+		{p: Parser{Pivot: 2069}, s: "2/6/70", want: NewDate(1970, time.February, 6)},
+		{p: Parser{Pivot: 2069}, s: "2/6/2019", want: NewDate(2019, time.February, 6)},
+		{p: Parser{Pivot: 2069}, s: "2/6/9", wantErr: true},
+
+		{p: Parser{MonthNames: true}, s: "February 6, 2019", want: NewDate(2019, time.February, 6)},
+		{p: Parser{MonthNames: true}, s: "6 Feb 2019", want: NewDate(2019, time.February, 6)},
+		{p: Parser{MonthNames: true}, s: "2/6/2019", want: NewDate(2019, time.February, 6)},
+		{p: Parser{MonthNames: true}, s: "Foo 6, 2019", wantErr: true},
+		{p: Parser{MonthNames: true}, s: "30 Feb 2019", wantErr: true},
+		{p: Parser{MonthNames: true}, s: "Feb 29, 2019", wantErr: true},
+		{p: Parser{MonthNames: true}, s: "Feb 29, 2020", want: NewDate(2020, time.February, 29)},
+		{p: Parser{MonthNames: true}, s: "Feb 6, 19", wantErr: true},
+		{p: Parser{MonthNames: true, Pivot: 2069}, s: "Feb 6, 19", want: NewDate(2019, time.February, 6)},
+		{p: Parser{MonthNames: true, TwoDigitYears: &TwoDigitYearPolicy{Pivot: 50, LowCentury: 20, HighCentury: 19}}, s: "6 Feb 99", want: NewDate(1999, time.February, 6)},
+	}
+	for _, test := range tests {
+		got, err := test.p.Parse(test.s)
+		if !got.Equal(test.want) || (err != nil) != test.wantErr {
+			errString := "<nil>"
+			if test.wantErr {
+				errString = "<non-nil>"
+			}
+			t.Errorf("%+v.Parse(%q) = %v, %v; want %v, %s", test.p, test.s, got, err, test.want, errString)
+		}
+	}
+}
+
+func TestTwoDigitYearPolicy(t *testing.T) {
+	tests := []struct {
+		policy TwoDigitYearPolicy
+		yy     int
+		want   int
+	}{
+		{policy: TwoDigitYearPolicy{Pivot: 50, LowCentury: 20, HighCentury: 19}, yy: 0, want: 2000},
+		{policy: TwoDigitYearPolicy{Pivot: 50, LowCentury: 20, HighCentury: 19}, yy: 49, want: 2049},
+		{policy: TwoDigitYearPolicy{Pivot: 50, LowCentury: 20, HighCentury: 19}, yy: 50, want: 1950},
+		{policy: TwoDigitYearPolicy{Pivot: 50, LowCentury: 20, HighCentury: 19}, yy: 99, want: 1999},
+		{policy: TwoDigitYearPolicy{Pivot: 30, LowCentury: 21, HighCentury: 20}, yy: 29, want: 2129},
+		{policy: TwoDigitYearPolicy{Pivot: 30, LowCentury: 21, HighCentury: 20}, yy: 30, want: 2030},
+		{policy: TwoDigitYearPolicy{Pivot: 0, HighCentury: 18}, yy: 0, want: 1800},
+		{policy: TwoDigitYearPolicy{Pivot: 100, LowCentury: 20}, yy: 99, want: 2099},
+	}
+	for _, test := range tests {
+		if got := test.policy.Year(test.yy); got != test.want {
+			t.Errorf("%+v.Year(%d) = %d; want %d", test.policy, test.yy, got, test.want)
+		}
+	}
+
+	p := Parser{
+		Pivot:         2069,
+		TwoDigitYears: &TwoDigitYearPolicy{Pivot: 50, LowCentury: 20, HighCentury: 19},
+	}
+	parserTests := []struct {
+		s    string
+		want Date
+	}{
+		{s: "2/6/49", want: NewDate(2049, time.February, 6)},
+		{s: "2/6/50", want: NewDate(1950, time.February, 6)},
+		{s: "2/6/2019", want: NewDate(2019, time.February, 6)},
+	}
+	for _, test := range parserTests {
+		if got, err := p.Parse(test.s); err != nil || got != test.want {
+			t.Errorf("Parse(%q) = %v, %v; want %v, <nil>", test.s, got, err, test.want)
+		}
+	}
+}
+
+func TestParseDateInLocale(t *testing.T) {
+	tests := []struct {
+		s       string
+		order   DayOrder
+		want    Date
+		wantErr bool
+	}{
+		{s: "06/02/2019", order: MDY, want: NewDate(2019, time.June, 2)},
+		{s: "06/02/2019", order: DMY, want: NewDate(2019, time.February, 6)},
+		{s: "2019/02/06", order: YMD, want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06", order: DMY, want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06", order: MDY, want: NewDate(2019, time.February, 6)},
+		{s: "6.2.2019", order: DMY, want: NewDate(2019, time.February, 6)},
+		{s: "31/04/2019", order: DMY, wantErr: true},
+		{s: "04/31/2019", order: MDY, wantErr: true},
+		{s: "29/02/2019", order: DMY, wantErr: true},
+		{s: "13/02/2019", order: MDY, wantErr: true},
+		{s: "06/02/19", order: DMY, wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDateInLocale(test.s, test.order)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseDateInLocale(%q, %v) = %v, %v; want %v, %s", test.s, test.order, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestParserParseAll(t *testing.T) {
+	p := Parser{Order: DMY, MonthNames: true, Pivot: 2069}
+	ss := []string{
+		"06.02.2019",
+		"6/2/19",
+		"6 Feb 2019",
+		"29.02.2019",
+		"",
+		"31-12-1999",
+	}
+	dates, errs := p.ParseAll(ss)
+	if len(dates) != len(ss) || len(errs) != len(ss) {
+		t.Fatalf("ParseAll(...) returned %d dates and %d errors; want %d each", len(dates), len(errs), len(ss))
+	}
+	for i, s := range ss {
+		want, wantErr := p.Parse(s)
+		if dates[i] != want || (errs[i] != nil) != (wantErr != nil) {
+			t.Errorf("ParseAll(...)[%d] = %v, %v; Parse(%q) = %v, %v", i, dates[i], errs[i], s, want, wantErr)
+		}
+	}
+
+	if _, errs := p.ParseAll(ss[:3]); errs != nil {
+		t.Errorf("ParseAll(%q) errors = %v; want nil", ss[:3], errs)
+	}
+}
+
+func BenchmarkParseAll(b *testing.B) {
+	ss := make([]string, 1000)
+	for i := range ss {
+		d := NewDate(2019, time.January, 1+i)
+		ss[i] = fmt.Sprintf("%d/%d/%d", d.Month(), d.Day(), d.Year())
+	}
+
+	b.Run("ParseAll", func(b *testing.B) {
+		b.ReportAllocs()
+		p := Parser{}
+		for i := 0; i < b.N; i++ {
+			if _, errs := p.ParseAll(ss); errs != nil {
+				b.Fatal(errs)
+			}
+		}
+	})
+	b.Run("ParseDate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			dates := make([]Date, len(ss))
+			for j, s := range ss {
+				var err error
+				dates[j], err = ParseDate(s)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
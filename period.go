@@ -0,0 +1,129 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Period is an amount of calendar time
+// expressed in years, months, and days.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// ParsePeriod parses an ISO 8601 duration that has only date components,
+// like "P1Y2M3D". Weeks are converted to days, so "P4W" is equal to "P28D".
+// Durations with time components, like "PT1H", are rejected.
+func ParsePeriod(s string) (Period, error) {
+	years, months, days, err := parseISODuration(s)
+	if err != nil {
+		return Period{}, fmt.Errorf("parse period %q: %v", s, err)
+	}
+	return Period{Years: years, Months: months, Days: days}, nil
+}
+
+// String returns p as an ISO 8601 duration, like "P1Y2M3D".
+// Zero components are omitted, and the zero Period is "P0D".
+// For periods with non-negative components,
+// the result can be parsed by [ParsePeriod].
+func (p Period) String() string {
+	if p == (Period{}) {
+		return "P0D"
+	}
+	buf := []byte{'P'}
+	if p.Years != 0 {
+		buf = strconv.AppendInt(buf, int64(p.Years), 10)
+		buf = append(buf, 'Y')
+	}
+	if p.Months != 0 {
+		buf = strconv.AppendInt(buf, int64(p.Months), 10)
+		buf = append(buf, 'M')
+	}
+	if p.Days != 0 {
+		buf = strconv.AppendInt(buf, int64(p.Days), 10)
+		buf = append(buf, 'D')
+	}
+	return string(buf)
+}
+
+// AddPeriodClamped returns the date p after d.
+// The years and months of p are applied first as by [Date.AddMonthsClamped],
+// so a day that does not exist in the resulting month
+// is clamped to the end of the month.
+// The days of p are then added as by [Date.AddDays].
+// For example, January 31, 2019 plus P1M1D is March 1,
+// whereas [Date.Add] would give March 4.
+func (d Date) AddPeriodClamped(p Period) Date {
+	return d.AddMonthsClamped(p.Years*12 + p.Months).AddDays(p.Days)
+}
+
+// parseISODuration parses an ISO 8601 duration
+// that has only date components, like "P1Y2M3D".
+// Weeks are converted to days.
+func parseISODuration(s string) (years, months, days int, err error) {
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok {
+		return 0, 0, 0, errors.New("duration must start with 'P'")
+	}
+	if strings.Contains(rest, "T") {
+		return 0, 0, 0, errors.New("time components not supported")
+	}
+	if rest == "" {
+		return 0, 0, 0, errors.New("empty duration")
+	}
+	const designators = "YMWD"
+	next := 0 // index into designators of the earliest allowed component
+	for rest != "" {
+		i := 0
+		for i < len(rest) && '0' <= rest[i] && rest[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return 0, 0, 0, fmt.Errorf("invalid component %q", rest)
+		}
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		j := strings.IndexByte(designators, rest[i])
+		if j < 0 {
+			return 0, 0, 0, fmt.Errorf("invalid designator %q", rest[i:i+1])
+		}
+		if j < next {
+			return 0, 0, 0, fmt.Errorf("designator %q out of order", rest[i:i+1])
+		}
+		next = j + 1
+		switch designators[j] {
+		case 'Y':
+			years = n
+		case 'M':
+			months = n
+		case 'W':
+			days += 7 * n
+		case 'D':
+			days += n
+		}
+		rest = rest[i+1:]
+	}
+	return years, months, days, nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Period
+		wantErr bool
+	}{
+		{s: "P1Y2M3D", want: Period{Years: 1, Months: 2, Days: 3}},
+		{s: "P1Y", want: Period{Years: 1}},
+		{s: "P18M", want: Period{Months: 18}},
+		{s: "P10D", want: Period{Days: 10}},
+		{s: "P0D", want: Period{}},
+		{s: "P4W", want: Period{Days: 28}},
+		{s: "P1W3D", want: Period{Days: 10}},
+		{s: "P1Y6M", want: Period{Years: 1, Months: 6}},
+		{s: "PT1H", wantErr: true},
+		{s: "P1DT12H", wantErr: true},
+		{s: "P", wantErr: true},
+		{s: "", wantErr: true},
+		{s: "1Y", wantErr: true},
+		{s: "P1D1Y", wantErr: true},
+		{s: "P1Y1Y", wantErr: true},
+		{s: "P-1D", wantErr: true},
+		{s: "P1.5Y", wantErr: true},
+		{s: "P1X", wantErr: true},
+		{s: "p1d", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParsePeriod(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParsePeriod(%q) = %+v, %v; want %+v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	tests := []struct {
+		p    Period
+		want string
+	}{
+		{p: Period{}, want: "P0D"},
+		{p: Period{Years: 1, Months: 2, Days: 3}, want: "P1Y2M3D"},
+		{p: Period{Years: 1, Days: 3}, want: "P1Y3D"},
+		{p: Period{Months: 18}, want: "P18M"},
+		{p: Period{Years: 2}, want: "P2Y"},
+		{p: Period{Days: 28}, want: "P28D"},
+	}
+	for _, test := range tests {
+		got := test.p.String()
+		if got != test.want {
+			t.Errorf("%+v.String() = %q; want %q", test.p, got, test.want)
+		}
+		if parsed, err := ParsePeriod(got); parsed != test.p || err != nil {
+			t.Errorf("ParsePeriod(%q) = %+v, %v; want %+v, <nil>", got, parsed, err, test.p)
+		}
+	}
+}
+
+func TestAddPeriodClamped(t *testing.T) {
+	tests := []struct {
+		d              Date
+		p              Period
+		want           Date
+		wantNormalized Date
+	}{
+		{
+			d:              NewDate(2019, time.January, 31),
+			p:              Period{Months: 1},
+			want:           NewDate(2019, time.February, 28),
+			wantNormalized: NewDate(2019, time.March, 3),
+		},
+		{
+			d:              NewDate(2020, time.January, 31),
+			p:              Period{Months: 1},
+			want:           NewDate(2020, time.February, 29),
+			wantNormalized: NewDate(2020, time.March, 2),
+		},
+		{
+			d:              NewDate(2019, time.January, 31),
+			p:              Period{Months: 1, Days: 1},
+			want:           NewDate(2019, time.March, 1),
+			wantNormalized: NewDate(2019, time.March, 4),
+		},
+		{
+			d:              NewDate(2020, time.February, 29),
+			p:              Period{Years: 1},
+			want:           NewDate(2021, time.February, 28),
+			wantNormalized: NewDate(2021, time.March, 1),
+		},
+		{
+			d:              NewDate(2019, time.August, 31),
+			p:              Period{Years: 1, Months: 1, Days: 30},
+			want:           NewDate(2020, time.October, 30),
+			wantNormalized: NewDate(2020, time.October, 31),
+		},
+		{
+			d:              NewDate(2019, time.February, 6),
+			p:              Period{Years: 1, Months: 2, Days: 3},
+			want:           NewDate(2020, time.April, 9),
+			wantNormalized: NewDate(2020, time.April, 9),
+		},
+		{
+			d:              NewDate(2019, time.March, 31),
+			p:              Period{Months: -1},
+			want:           NewDate(2019, time.February, 28),
+			wantNormalized: NewDate(2019, time.March, 3),
+		},
+	}
+	for _, test := range tests {
+		if got := test.d.AddPeriodClamped(test.p); got != test.want {
+			t.Errorf("%v.AddPeriodClamped(%v) = %v; want %v", test.d, test.p, got, test.want)
+		}
+		if got := test.d.Add(test.p.Years, test.p.Months, test.p.Days); got != test.wantNormalized {
+			t.Errorf("%v.Add(%d, %d, %d) = %v; want %v", test.d, test.p.Years, test.p.Months, test.p.Days, got, test.wantNormalized)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"fmt"
+	"iter"
+	"time"
+)
+
+// A YearQuarter is a quarter of a particular year.
+// Quarter 1 is January through March.
+type YearQuarter struct {
+	Year    int
+	Quarter int
+}
+
+// String returns the quarter in the form "2006-Q1".
+func (yq YearQuarter) String() string {
+	return fmt.Sprintf("%04d-Q%d", yq.Year, yq.Quarter)
+}
+
+// Quarter returns the quarter of the year containing d, in the range [1,4].
+// Quarter 1 is January through March.
+func (d Date) Quarter() int {
+	return d.month/3 + 1
+}
+
+// quarterOf returns the quarter containing d.
+func quarterOf(d Date) YearQuarter {
+	return YearQuarter{Year: d.Year(), Quarter: d.Quarter()}
+}
+
+// StartOfQuarter returns the first day of the quarter containing d.
+func (d Date) StartOfQuarter() Date {
+	return NewDate(d.Year(), time.Month(d.month/3*3+1), 1)
+}
+
+// EndOfQuarter returns the last day of the quarter containing d.
+func (d Date) EndOfQuarter() Date {
+	return NewDate(d.Year(), time.Month(d.month/3*3+4), 0)
+}
+
+// NextQuarter returns the first day of the quarter after the one containing d.
+func (d Date) NextQuarter() Date {
+	return NewDate(d.Year(), time.Month(d.month/3*3+4), 1)
+}
+
+// PreviousQuarter returns the first day of the quarter
+// before the one containing d.
+func (d Date) PreviousQuarter() Date {
+	return NewDate(d.Year(), time.Month(d.month/3*3-2), 1)
+}
+
+// QuartersBetween returns an iterator over the quarters
+// that contain at least one date in the inclusive range [start, end].
+// If end is before start, the iterator yields nothing.
+func QuartersBetween(start, end Date) iter.Seq[YearQuarter] {
+	return func(yield func(YearQuarter) bool) {
+		if end.Before(start) {
+			return
+		}
+		last := quarterOf(end)
+		for q := quarterOf(start); ; {
+			if !yield(q) || q == last {
+				return
+			}
+			if q.Quarter == 4 {
+				q = YearQuarter{Year: q.Year + 1, Quarter: 1}
+			} else {
+				q.Quarter++
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestQuartersBetween(t *testing.T) {
+	tests := []struct {
+		start, end Date
+		want       []YearQuarter
+	}{
+		{
+			start: NewDate(2019, time.February, 6),
+			end:   NewDate(2019, time.March, 31),
+			want:  []YearQuarter{{2019, 1}},
+		},
+		{
+			start: NewDate(2019, time.February, 6),
+			end:   NewDate(2019, time.February, 6),
+			want:  []YearQuarter{{2019, 1}},
+		},
+		{
+			start: NewDate(2019, time.March, 31),
+			end:   NewDate(2019, time.April, 1),
+			want:  []YearQuarter{{2019, 1}, {2019, 2}},
+		},
+		{
+			start: NewDate(2019, time.November, 15),
+			end:   NewDate(2020, time.May, 1),
+			want:  []YearQuarter{{2019, 4}, {2020, 1}, {2020, 2}},
+		},
+		{
+			start: NewDate(2020, time.May, 1),
+			end:   NewDate(2019, time.November, 15),
+			want:  nil,
+		},
+	}
+	for _, test := range tests {
+		got := slices.Collect(QuartersBetween(test.start, test.end))
+		if !slices.Equal(got, test.want) {
+			t.Errorf("QuartersBetween(%v, %v) = %v; want %v", test.start, test.end, got, test.want)
+		}
+	}
+
+	// Stopping early.
+	for q := range QuartersBetween(NewDate(2019, time.January, 1), NewDate(2020, time.December, 31)) {
+		if q != (YearQuarter{2019, 1}) {
+			t.Errorf("first quarter = %v; want 2019-Q1", q)
+		}
+		break
+	}
+}
+
+func TestYearQuarterString(t *testing.T) {
+	if got, want := (YearQuarter{2019, 1}).String(), "2019-Q1"; got != want {
+		t.Errorf("YearQuarter{2019, 1}.String() = %q; want %q", got, want)
+	}
+}
+
+func TestQuarter(t *testing.T) {
+	tests := []struct {
+		d    Date
+		want int
+	}{
+		{d: NewDate(2019, time.January, 1), want: 1},
+		{d: NewDate(2019, time.March, 31), want: 1},
+		{d: NewDate(2019, time.April, 1), want: 2},
+		{d: NewDate(2019, time.June, 30), want: 2},
+		{d: NewDate(2019, time.July, 1), want: 3},
+		{d: NewDate(2019, time.September, 30), want: 3},
+		{d: NewDate(2019, time.October, 1), want: 4},
+		{d: NewDate(2019, time.December, 31), want: 4},
+	}
+	for _, test := range tests {
+		if got := test.d.Quarter(); got != test.want {
+			t.Errorf("%v.Quarter() = %d; want %d", test.d, got, test.want)
+		}
+	}
+}
+
+func TestQuarterNavigation(t *testing.T) {
+	tests := []struct {
+		d        Date
+		start    Date
+		end      Date
+		next     Date
+		previous Date
+	}{
+		{
+			d:        NewDate(2019, time.January, 1),
+			start:    NewDate(2019, time.January, 1),
+			end:      NewDate(2019, time.March, 31),
+			next:     NewDate(2019, time.April, 1),
+			previous: NewDate(2018, time.October, 1),
+		},
+		{
+			d:        NewDate(2019, time.March, 31),
+			start:    NewDate(2019, time.January, 1),
+			end:      NewDate(2019, time.March, 31),
+			next:     NewDate(2019, time.April, 1),
+			previous: NewDate(2018, time.October, 1),
+		},
+		{
+			d:        NewDate(2019, time.May, 15),
+			start:    NewDate(2019, time.April, 1),
+			end:      NewDate(2019, time.June, 30),
+			next:     NewDate(2019, time.July, 1),
+			previous: NewDate(2019, time.January, 1),
+		},
+		{
+			d:        NewDate(2019, time.July, 1),
+			start:    NewDate(2019, time.July, 1),
+			end:      NewDate(2019, time.September, 30),
+			next:     NewDate(2019, time.October, 1),
+			previous: NewDate(2019, time.April, 1),
+		},
+		{
+			d:        NewDate(2019, time.December, 31),
+			start:    NewDate(2019, time.October, 1),
+			end:      NewDate(2019, time.December, 31),
+			next:     NewDate(2020, time.January, 1),
+			previous: NewDate(2019, time.July, 1),
+		},
+	}
+	for _, test := range tests {
+		if got := test.d.StartOfQuarter(); !got.Equal(test.start) {
+			t.Errorf("%v.StartOfQuarter() = %v; want %v", test.d, got, test.start)
+		}
+		if got := test.d.EndOfQuarter(); !got.Equal(test.end) {
+			t.Errorf("%v.EndOfQuarter() = %v; want %v", test.d, got, test.end)
+		}
+		if got := test.d.NextQuarter(); !got.Equal(test.next) {
+			t.Errorf("%v.NextQuarter() = %v; want %v", test.d, got, test.next)
+		}
+		if got := test.d.PreviousQuarter(); !got.Equal(test.previous) {
+			t.Errorf("%v.PreviousQuarter() = %v; want %v", test.d, got, test.previous)
+		}
+	}
+}
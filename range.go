@@ -0,0 +1,202 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A DateRange is an inclusive range of dates.
+// A DateRange whose End is before its Start is empty.
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// Range returns an iterator over the dates from start up to but not including end.
+// If end is not after start, the iterator yields nothing.
+func Range(start, end Date) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for d := start; d.Before(end); d = d.AddDays(1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// RangeInclusive returns an iterator over the dates from start through end.
+// If end is before start, the iterator yields nothing.
+func RangeInclusive(start, end Date) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for d := start; !end.Before(d); d = d.AddDays(1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// WeekdayHistogram returns the number of days in r
+// that fall on each day of the week, indexed by [time.Weekday].
+func (r DateRange) WeekdayHistogram() [7]int {
+	var hist [7]int
+	n := r.End.absDays() - r.Start.absDays() + 1
+	if n <= 0 {
+		return hist
+	}
+	for i := range hist {
+		hist[i] = n / 7
+	}
+	first := int(r.Start.Weekday())
+	for i := 0; i < n%7; i++ {
+		hist[(first+i)%7]++
+	}
+	return hist
+}
+
+// String returns a compact English description of r,
+// omitting the month and year of the start date when they are shared
+// with the end date, like "Feb 6–10, 2019" or "Feb 6 – Mar 3, 2019".
+// An empty range is "empty".
+func (r DateRange) String() string {
+	start, end := r.Start, r.End
+	switch {
+	case end.Before(start):
+		return "empty"
+	case start == end:
+		return fmt.Sprintf("%s %d, %d", start.Month().String()[:3], start.Day(), start.Year())
+	case start.EqualMonth(end):
+		return fmt.Sprintf("%s %d–%d, %d", start.Month().String()[:3], start.Day(), end.Day(), end.Year())
+	case start.Year() == end.Year():
+		return fmt.Sprintf("%s %d – %s %d, %d",
+			start.Month().String()[:3], start.Day(),
+			end.Month().String()[:3], end.Day(), end.Year())
+	default:
+		return fmt.Sprintf("%s %d, %d – %s %d, %d",
+			start.Month().String()[:3], start.Day(), start.Year(),
+			end.Month().String()[:3], end.Day(), end.Year())
+	}
+}
+
+// ParsePeriodNatural parses a period of a year written period first:
+// a quarter ("Q1 2019"), a half year ("H2 2019"),
+// or an ISO 8601 week ("week 6 2019").
+// It returns the range of dates in the period.
+func ParsePeriodNatural(s string) (DateRange, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	var kind, numPart, yearPart string
+	switch {
+	case len(fields) == 2 && len(fields[0]) > 1:
+		kind, numPart, yearPart = fields[0][:1], fields[0][1:], fields[1]
+	case len(fields) == 3 && fields[0] == "week":
+		kind, numPart, yearPart = "w", fields[1], fields[2]
+	default:
+		return DateRange{}, fmt.Errorf("parse period %q: unknown format", s)
+	}
+	n, err := strconv.Atoi(numPart)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("parse period %q: %v", s, err)
+	}
+	year, err := strconv.Atoi(yearPart)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("parse period %q: year: %v", s, err)
+	}
+	switch kind {
+	case "q":
+		if !(1 <= n && n <= 4) {
+			return DateRange{}, fmt.Errorf("parse period %q: invalid quarter %d", s, n)
+		}
+		start := NewDate(year, time.Month(3*n-2), 1)
+		return DateRange{Start: start, End: start.Add(0, 3, -1)}, nil
+	case "h":
+		if !(1 <= n && n <= 2) {
+			return DateRange{}, fmt.Errorf("parse period %q: invalid half %d", s, n)
+		}
+		start := NewDate(year, time.Month(6*n-5), 1)
+		return DateRange{Start: start, End: start.Add(0, 6, -1)}, nil
+	case "w":
+		if !(1 <= n && n <= weeksInYear(year, time.Monday)) {
+			return DateRange{}, fmt.Errorf("parse period %q: invalid week %d", s, n)
+		}
+		start := weekOneStart(year, time.Monday).absDays() + 7*(n-1)
+		return DateRange{Start: dateFromAbsDays(start), End: dateFromAbsDays(start + 6)}, nil
+	default:
+		return DateRange{}, fmt.Errorf("parse period %q: unknown format", s)
+	}
+}
+
+// ParseISOInterval parses an ISO 8601 time interval of dates.
+// The interval may be given as a start and end date ("2006-01-02/2006-01-05"),
+// a start date and a duration ("2006-01-02/P3D"),
+// or a duration and an end date ("P3D/2006-01-05").
+// Durations may only have date components (years, months, weeks, and days).
+// Adding the duration to the start date gives the end date,
+// so "2006-01-02/P3D" ends on January 5.
+// Years and months are added as by [Date.AddPeriodClamped],
+// so "2019-01-31/P1M" ends on February 28
+// and "P1M/2019-03-31" starts on February 28.
+func ParseISOInterval(s string) (DateRange, error) {
+	startPart, endPart, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return DateRange{}, fmt.Errorf("parse interval %q: missing '/'", s)
+	}
+	var r DateRange
+	switch {
+	case strings.HasPrefix(startPart, "P") && strings.HasPrefix(endPart, "P"):
+		return DateRange{}, fmt.Errorf("parse interval %q: two durations", s)
+	case strings.HasPrefix(endPart, "P"):
+		years, months, days, err := parseISODuration(endPart)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("parse interval %q: %v", s, err)
+		}
+		r.Start, err = parseISODate(startPart, true)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("parse interval %q: %v", s, err)
+		}
+		r.End = r.Start.AddPeriodClamped(Period{Years: years, Months: months, Days: days})
+	case strings.HasPrefix(startPart, "P"):
+		years, months, days, err := parseISODuration(startPart)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("parse interval %q: %v", s, err)
+		}
+		r.End, err = parseISODate(endPart, true)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("parse interval %q: %v", s, err)
+		}
+		// Undo the steps of AddPeriodClamped in reverse order.
+		r.Start = r.End.AddDays(-days).AddMonthsClamped(-(years*12 + months))
+	default:
+		var err error
+		r.Start, err = parseISODate(startPart, true)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("parse interval %q: %v", s, err)
+		}
+		r.End, err = parseISODate(endPart, true)
+		if err != nil {
+			return DateRange{}, fmt.Errorf("parse interval %q: %v", s, err)
+		}
+		if r.End.Before(r.Start) {
+			return DateRange{}, fmt.Errorf("parse interval %q: end before start", s)
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,271 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		start, end    Date
+		want          []Date
+		wantInclusive []Date
+	}{
+		{
+			start: NewDate(2019, time.February, 27),
+			end:   NewDate(2019, time.March, 2),
+			want: []Date{
+				NewDate(2019, time.February, 27),
+				NewDate(2019, time.February, 28),
+				NewDate(2019, time.March, 1),
+			},
+			wantInclusive: []Date{
+				NewDate(2019, time.February, 27),
+				NewDate(2019, time.February, 28),
+				NewDate(2019, time.March, 1),
+				NewDate(2019, time.March, 2),
+			},
+		},
+		{
+			start:         NewDate(2019, time.February, 6),
+			end:           NewDate(2019, time.February, 6),
+			want:          nil,
+			wantInclusive: []Date{NewDate(2019, time.February, 6)},
+		},
+		{
+			start:         NewDate(2019, time.February, 6),
+			end:           NewDate(2019, time.February, 5),
+			want:          nil,
+			wantInclusive: nil,
+		},
+	}
+	for _, test := range tests {
+		if got := slices.Collect(Range(test.start, test.end)); !slices.Equal(got, test.want) {
+			t.Errorf("Range(%v, %v) = %v; want %v", test.start, test.end, got, test.want)
+		}
+		if got := slices.Collect(RangeInclusive(test.start, test.end)); !slices.Equal(got, test.wantInclusive) {
+			t.Errorf("RangeInclusive(%v, %v) = %v; want %v", test.start, test.end, got, test.wantInclusive)
+		}
+	}
+
+	// Stopping early.
+	n := 0
+	for range RangeInclusive(NewDate(2019, time.January, 1), NewDate(2019, time.December, 31)) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("broke out of RangeInclusive after %d iterations; want 3", n)
+	}
+}
+
+func TestWeekdayHistogram(t *testing.T) {
+	tests := []DateRange{
+		{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 6)},
+		{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 15)},
+		{Start: NewDate(2019, time.December, 28), End: NewDate(2020, time.January, 9)},
+		{Start: NewDate(2020, time.February, 1), End: NewDate(2020, time.March, 31)},
+		{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 5)},
+	}
+	for _, r := range tests {
+		var want [7]int
+		for d := r.Start; !r.End.Before(d); d = d.Add(0, 0, 1) {
+			want[time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC).Weekday()]++
+		}
+		if got := r.WeekdayHistogram(); got != want {
+			t.Errorf("DateRange{%v, %v}.WeekdayHistogram() = %v; want %v", r.Start, r.End, got, want)
+		}
+	}
+}
+
+func TestWeekdayHistogramLongRange(t *testing.T) {
+	r := DateRange{Start: NewDate(1900, time.January, 1), End: NewDate(2099, time.December, 31)}
+	const wantLen = 73049
+	hist := r.WeekdayHistogram()
+	sum := 0
+	for _, n := range hist {
+		sum += n
+	}
+	if sum != wantLen {
+		t.Errorf("sum(DateRange{%v, %v}.WeekdayHistogram()) = %d; want %d", r.Start, r.End, sum, wantLen)
+	}
+	// 1900-01-01 was a Monday.
+	if got, want := hist[time.Monday], wantLen/7+1; got != want {
+		t.Errorf("DateRange{%v, %v}.WeekdayHistogram()[time.Monday] = %d; want %d", r.Start, r.End, got, want)
+	}
+}
+
+func TestDateRangeString(t *testing.T) {
+	tests := []struct {
+		r    DateRange
+		want string
+	}{
+		{
+			r:    DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 6)},
+			want: "Feb 6, 2019",
+		},
+		{
+			r:    DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 10)},
+			want: "Feb 6–10, 2019",
+		},
+		{
+			r:    DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.March, 3)},
+			want: "Feb 6 – Mar 3, 2019",
+		},
+		{
+			r:    DateRange{Start: NewDate(2019, time.December, 28), End: NewDate(2020, time.January, 3)},
+			want: "Dec 28, 2019 – Jan 3, 2020",
+		},
+		{
+			r:    DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2020, time.February, 10)},
+			want: "Feb 6, 2019 – Feb 10, 2020",
+		},
+		{
+			r:    DateRange{Start: NewDate(2019, time.February, 10), End: NewDate(2019, time.February, 6)},
+			want: "empty",
+		},
+		{
+			r:    DateRange{Start: NewDate(2020, time.January, 3), End: NewDate(2019, time.December, 28)},
+			want: "empty",
+		},
+	}
+	for _, test := range tests {
+		if got := test.r.String(); got != test.want {
+			t.Errorf("DateRange{%v, %v}.String() = %q; want %q", test.r.Start, test.r.End, got, test.want)
+		}
+	}
+}
+
+func TestParsePeriodNatural(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    DateRange
+		wantErr bool
+	}{
+		{s: "Q1 2019", want: DateRange{Start: NewDate(2019, time.January, 1), End: NewDate(2019, time.March, 31)}},
+		{s: "q2 2019", want: DateRange{Start: NewDate(2019, time.April, 1), End: NewDate(2019, time.June, 30)}},
+		{s: "Q4 2019", want: DateRange{Start: NewDate(2019, time.October, 1), End: NewDate(2019, time.December, 31)}},
+		{s: "H1 2020", want: DateRange{Start: NewDate(2020, time.January, 1), End: NewDate(2020, time.June, 30)}},
+		{s: "H2 2019", want: DateRange{Start: NewDate(2019, time.July, 1), End: NewDate(2019, time.December, 31)}},
+		{s: "week 6 2019", want: DateRange{Start: NewDate(2019, time.February, 4), End: NewDate(2019, time.February, 10)}},
+		{s: "Week 1 2019", want: DateRange{Start: NewDate(2018, time.December, 31), End: NewDate(2019, time.January, 6)}},
+		{s: "Q9 2019", wantErr: true},
+		{s: "Q0 2019", wantErr: true},
+		{s: "H3 2019", wantErr: true},
+		{s: "week 53 2019", wantErr: true},
+		{s: "2019 Q1", wantErr: true},
+		{s: "X1 2019", wantErr: true},
+		{s: "Q1", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParsePeriodNatural(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParsePeriodNatural(%q) = %v, %v; want %v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestParseISOInterval(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    DateRange
+		wantErr bool
+	}{
+		{
+			s:    "2019-02-06/2019-02-10",
+			want: DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 10)},
+		},
+		{
+			s:    "2019-02-06/2019-02-06",
+			want: DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 6)},
+		},
+		{
+			s:    "2019-02-06/P4D",
+			want: DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 10)},
+		},
+		{
+			s:    "2019-02-06/P1W",
+			want: DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 13)},
+		},
+		{
+			s:    "2019-02-06/P1Y1M1D",
+			want: DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2020, time.March, 7)},
+		},
+		{
+			s:    "P4D/2019-02-10",
+			want: DateRange{Start: NewDate(2019, time.February, 6), End: NewDate(2019, time.February, 10)},
+		},
+		{
+			s:    "2019-01-31/P1M",
+			want: DateRange{Start: NewDate(2019, time.January, 31), End: NewDate(2019, time.February, 28)},
+		},
+		{
+			s:    "2020-01-31/P1M",
+			want: DateRange{Start: NewDate(2020, time.January, 31), End: NewDate(2020, time.February, 29)},
+		},
+		{
+			s:    "2019-01-31/P1M1D",
+			want: DateRange{Start: NewDate(2019, time.January, 31), End: NewDate(2019, time.March, 1)},
+		},
+		{
+			s:    "2020-02-29/P1Y",
+			want: DateRange{Start: NewDate(2020, time.February, 29), End: NewDate(2021, time.February, 28)},
+		},
+		{
+			s:    "P1M/2019-03-31",
+			want: DateRange{Start: NewDate(2019, time.February, 28), End: NewDate(2019, time.March, 31)},
+		},
+		{
+			s:    "P1Y/2021-02-28",
+			want: DateRange{Start: NewDate(2020, time.February, 28), End: NewDate(2021, time.February, 28)},
+		},
+		{
+			s:    "P1Y/2024-02-29",
+			want: DateRange{Start: NewDate(2023, time.February, 28), End: NewDate(2024, time.February, 29)},
+		},
+		{s: "2019-02-10/2019-02-06", wantErr: true},
+		{s: "2019-02-06", wantErr: true},
+		{s: "2019-02-06/", wantErr: true},
+		{s: "2019-02-06/PT4H", wantErr: true},
+		{s: "2019-02-06/P", wantErr: true},
+		{s: "2019-02-06/P4", wantErr: true},
+		{s: "2019-02-06/P4D1Y", wantErr: true},
+		{s: "2019-02-06/P1.5D", wantErr: true},
+		{s: "P1D/P2D", wantErr: true},
+		{s: "2019-02-30/P1D", wantErr: true},
+		{s: "2/6/2019/P1D", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseISOInterval(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseISOInterval(%q) = %v, %v; want %v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseDateTolerant parses a date like [ParseDate],
+// but accepts additional decorations commonly found in hand-written input.
+//
+// A trailing parenthesized day of the week, like "2006-01-02 (Mon)", is permitted.
+// The day of the week may be written in full or abbreviated to three letters
+// and must match the parsed date.
+//
+// An ISO 8601 date may be followed by a time zone designator
+// without a time of day, like "2006-01-02Z" or "2006-01-02+07:00".
+// The time zone is ignored.
+//
+// Dates may use English month names or three-letter abbreviations,
+// as in "February 6, 2019", "6 Feb 2019", or "February 2019".
+// "Sept" is also accepted for September.
+// A month and year without a day is treated as the first day of the month.
+// The year may directly follow an abbreviated month, as in "Feb2019" or "Feb-2019".
+// The day may have an English ordinal suffix, as in "Feb 6th, 2019",
+// which must be the correct suffix for the number.
+//
+// The input may be surrounded by matching single or double quotes,
+// as found in loosely quoted CSV or JSON-like text.
+//
+// A date or a bare year may be followed by an era: "AD", "CE", "BC", or "BCE".
+// A bare year is treated as January 1 of that year.
+// Years before the common era are converted to astronomical year numbering,
+// so "44 BC" is year -43.
+func ParseDateTolerant(s string) (Date, error) {
+	return parseDateTolerant(s, 0)
+}
+
+// ParseDateTolerantPivot parses a date like [ParseDateTolerant],
+// but also accepts an apostrophe-prefixed two-digit year
+// after a month name, as in "Feb '19" or "6 Feb '19".
+// The two-digit year is interpreted as the latest year
+// no later than pivot with the same last two digits,
+// as in [ParseDatePivot].
+func ParseDateTolerantPivot(s string, pivot int) (Date, error) {
+	return parseDateTolerant(s, pivot)
+}
+
+// parseDateTolerant implements [ParseDateTolerant] and [ParseDateTolerantPivot].
+// A pivot of zero rejects apostrophe-prefixed two-digit years.
+func parseDateTolerant(s string, pivot int) (Date, error) {
+	orig := s
+	s = trimQuotes(strings.TrimSpace(s))
+	weekdayName := ""
+	hasWeekday := strings.HasSuffix(s, ")")
+	if hasWeekday {
+		i := strings.LastIndex(s, "(")
+		if i < 0 {
+			return Date{}, fmt.Errorf("parse date %q: unbalanced parentheses", orig)
+		}
+		weekdayName = strings.TrimSpace(s[i+1 : len(s)-1])
+		s = strings.TrimSpace(s[:i])
+	}
+	s, beforeCommonEra, hasEra := cutEraSuffix(s)
+	s = trimZoneSuffix(s)
+
+	var d Date
+	if hasEra && isDigits(s) {
+		year, err := strconv.Atoi(s)
+		if err != nil {
+			return Date{}, fmt.Errorf("parse date %q: year: %v", orig, err)
+		}
+		d = NewDate(year, time.January, 1)
+	} else if hasLetter(s) {
+		var err error
+		d, err = parseMonthNameDate(s, hasEra, pivot)
+		if err != nil {
+			return Date{}, err
+		}
+	} else {
+		var err error
+		d, err = ParseDate(s)
+		if err != nil {
+			return Date{}, err
+		}
+	}
+	if hasEra {
+		if d.Year() < 1 {
+			return Date{}, fmt.Errorf("parse date %q: invalid year %d", orig, d.Year())
+		}
+		if beforeCommonEra {
+			d = NewDate(1-d.Year(), d.Month(), d.Day())
+		}
+	}
+
+	if hasWeekday {
+		w, ok := lookupWeekday(weekdayName)
+		if !ok {
+			return Date{}, fmt.Errorf("parse date %q: unknown day of week %q", orig, weekdayName)
+		}
+		if got := d.Weekday(); got != w {
+			return Date{}, fmt.Errorf("parse date %q: %v is a %v, not a %v", orig, d, got, w)
+		}
+	}
+	return d, nil
+}
+
+// parseMonthNameDate parses a date that uses an English month name
+// in one of the forms "January 2, 2006", "2 January 2006", or "January 2006".
+// Years before 100 are rejected unless shortYearOK is true.
+// An apostrophe-prefixed two-digit year like "'19" is resolved using pivot
+// and is rejected if pivot is zero.
+func parseMonthNameDate(s string, shortYearOK bool, pivot int) (Date, error) {
+	yearPart, month, day, err := splitMonthNameDate(s)
+	if err != nil {
+		return Date{}, err
+	}
+	if yy, ok := strings.CutPrefix(yearPart, "'"); ok {
+		if len(yy) != 2 || !isDigits(yy) {
+			return Date{}, fmt.Errorf("parse date %q: invalid year %q", s, yearPart)
+		}
+		if pivot == 0 {
+			return Date{}, fmt.Errorf("parse date %q: two-digit years not allowed", s)
+		}
+		year, _ := strconv.Atoi(yy)
+		return NewDate(pivot-floorMod(pivot-year, 100), month, day), nil
+	}
+	year, err := strconv.Atoi(yearPart)
+	if err != nil {
+		return Date{}, fmt.Errorf("parse date %q: year: %v", s, err)
+	}
+	if year < 100 && !shortYearOK {
+		return Date{}, fmt.Errorf("parse date %q: short years not allowed", s)
+	}
+	return NewDate(year, month, day), nil
+}
+
+// splitMonthNameDate splits a date that uses an English month name
+// into its unparsed year, month, and day of the month.
+// The day is in the range [1,31], but may not exist in the month.
+func splitMonthNameDate(s string) (yearPart string, month time.Month, day int, err error) {
+	fields := strings.FieldsFunc(s, func(c rune) bool { return c == ' ' || c == '\t' || c == ',' })
+	var monthPart, dayPart string
+	switch {
+	case len(fields) == 1 && !isDigit(fields[0][0]):
+		// Month name directly followed by the year, as in "Feb2019", "Feb-2019", or "Feb'19".
+		f := fields[0]
+		i := strings.IndexFunc(f, func(c rune) bool { return c == '-' || c == '\'' || '0' <= c && c <= '9' })
+		if i < 0 {
+			return "", 0, 0, fmt.Errorf("parse date %q: unknown format", s)
+		}
+		monthPart, dayPart, yearPart = f[:i], "1", strings.TrimPrefix(f[i:], "-")
+	case len(fields) == 2:
+		monthPart, dayPart, yearPart = fields[0], "1", fields[1]
+	case len(fields) == 3 && !isDigit(fields[0][0]):
+		monthPart, dayPart, yearPart = fields[0], fields[1], fields[2]
+	case len(fields) == 3:
+		dayPart, monthPart, yearPart = fields[0], fields[1], fields[2]
+	default:
+		return "", 0, 0, fmt.Errorf("parse date %q: unknown format", s)
+	}
+	month, ok := lookupMonth(monthPart)
+	if !ok {
+		return "", 0, 0, fmt.Errorf("parse date %q: unknown month %q", s, monthPart)
+	}
+	day, suffix, _ := cutNumber(dayPart)
+	if suffix != "" && !strings.EqualFold(suffix, ordinalSuffix(day)) {
+		return "", 0, 0, fmt.Errorf("parse date %q: invalid day %q", s, dayPart)
+	}
+	if !(1 <= day && day <= 31) {
+		return "", 0, 0, fmt.Errorf("parse date %q: invalid day %d", s, day)
+	}
+	return yearPart, month, day, nil
+}
+
+// ordinalSuffix returns the English ordinal suffix for n, like "st" for 1.
+func ordinalSuffix(n int) string {
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		return "th"
+	case n%10 == 1:
+		return "st"
+	case n%10 == 2:
+		return "nd"
+	case n%10 == 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
+
+// monthNames maps lowercased English month names
+// and their three-letter abbreviations to months.
+// It also includes the common abbreviation "sept".
+var monthNames = func() map[string]time.Month {
+	m := make(map[string]time.Month)
+	for month := time.January; month <= time.December; month++ {
+		name := strings.ToLower(month.String())
+		m[name] = month
+		m[name[:3]] = month
+	}
+	m["sept"] = time.September
+	return m
+}()
+
+// lookupMonth returns the month with the given English name
+// or abbreviation, ignoring case.
+func lookupMonth(name string) (time.Month, bool) {
+	month, ok := monthNames[strings.ToLower(name)]
+	return month, ok
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func hasLetter(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i] | 0x20; 'a' <= c && c <= 'z' {
+			return true
+		}
+	}
+	return false
+}
+
+// trimQuotes removes a matching pair of single or double quotes
+// surrounding s, along with any whitespace inside them.
+func trimQuotes(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return strings.TrimSpace(s[1 : len(s)-1])
+	}
+	return s
+}
+
+// cutEraSuffix removes a trailing "AD", "CE", "BC", or "BCE" from s.
+func cutEraSuffix(s string) (rest string, beforeCommonEra, ok bool) {
+	i := strings.LastIndexAny(s, " \t")
+	if i < 0 {
+		return s, false, false
+	}
+	switch strings.ToUpper(s[i+1:]) {
+	case "AD", "CE":
+		return strings.TrimSpace(s[:i]), false, true
+	case "BC", "BCE":
+		return strings.TrimSpace(s[:i]), true, true
+	default:
+		return s, false, false
+	}
+}
+
+// trimZoneSuffix removes a trailing time zone designator
+// ("Z", "±hh:mm", "±hhmm", or "+hh") from an ISO 8601 date.
+// s is left unchanged unless it starts with a year of at least four digits,
+// optionally signed, so that the year of a date like "2-6-2019"
+// is not mistaken for a time zone.
+func trimZoneSuffix(s string) string {
+	rest := s
+	if strings.HasPrefix(rest, "+") || strings.HasPrefix(rest, "-") {
+		rest = rest[1:]
+	}
+	if i := strings.IndexByte(rest, '-'); i < 4 || !isDigits(rest[:i]) {
+		return s
+	}
+	if rest, ok := strings.CutSuffix(s, "Z"); ok {
+		return rest
+	}
+	for _, layout := range []string{"+00:00", "+0000", "+00"} {
+		if len(s) <= len(layout) {
+			continue
+		}
+		zone := s[len(s)-len(layout):]
+		if matchesZoneLayout(zone, layout) {
+			return s[:len(s)-len(layout)]
+		}
+	}
+	return s
+}
+
+// matchesZoneLayout reports whether zone has the same shape as layout,
+// where '0' in layout matches any digit and '+' matches a sign.
+// A two-digit offset must be positive, since "-hh" is indistinguishable
+// from the day of an ISO 8601 date.
+func matchesZoneLayout(zone, layout string) bool {
+	for i := 0; i < len(layout); i++ {
+		switch c := zone[i]; layout[i] {
+		case '+':
+			if c != '+' && (c != '-' || len(layout) == len("+00")) {
+				return false
+			}
+		case '0':
+			if !('0' <= c && c <= '9') {
+				return false
+			}
+		default:
+			if c != layout[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// lookupWeekday returns the day of the week with the given English name
+// or three-letter abbreviation, ignoring case.
+func lookupWeekday(name string) (time.Weekday, bool) {
+	for w := time.Sunday; w <= time.Saturday; w++ {
+		full := w.String()
+		if strings.EqualFold(name, full) || strings.EqualFold(name, full[:3]) {
+			return w, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateTolerant(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{s: "2019-02-06", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06 (Wed)", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06 (wednesday)", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06(WED)", want: NewDate(2019, time.February, 6)},
+		{s: "2/6/2019 (Wed)", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06 (Thu)", wantErr: true},
+		{s: "2019-02-06 (Xyz)", wantErr: true},
+		{s: "2019-02-06 ()", wantErr: true},
+		{s: "2019-02-06 Wed)", wantErr: true},
+		{s: "(Wed)", wantErr: true},
+		{s: "2019-02-06Z", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06+05:00", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06-08:00", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06+0530", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06-0800", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06+09", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06Z (Wed)", want: NewDate(2019, time.February, 6)},
+		{s: "2019-02-06xyz", wantErr: true},
+		{s: "2019-02-06+5:00", wantErr: true},
+		{s: "2019-02Z", wantErr: true},
+		{s: "44 BC", want: NewDate(-43, time.January, 1)},
+		{s: "1 BC", want: NewDate(0, time.January, 1)},
+		{s: "44 bce", want: NewDate(-43, time.January, 1)},
+		{s: "2019 AD", want: NewDate(2019, time.January, 1)},
+		{s: "2019 CE", want: NewDate(2019, time.January, 1)},
+		{s: "0044-03-15 BC", want: NewDate(-43, time.March, 15)},
+		{s: "2019-02-06 AD (Wed)", want: NewDate(2019, time.February, 6)},
+		{s: "2019", wantErr: true},
+		{s: "0 BC", wantErr: true},
+		{s: "BC", wantErr: true},
+		{s: "2019 XY", wantErr: true},
+		{s: "February 2019", want: NewDate(2019, time.February, 1)},
+		{s: "Feb 2019", want: NewDate(2019, time.February, 1)},
+		{s: "feb 2019", want: NewDate(2019, time.February, 1)},
+		{s: "February 6, 2019", want: NewDate(2019, time.February, 6)},
+		{s: "Feb 6 2019", want: NewDate(2019, time.February, 6)},
+		{s: "6 February 2019", want: NewDate(2019, time.February, 6)},
+		{s: "Wednesday (Feb 6, 2019)", wantErr: true},
+		{s: "February 6, 2019 (Wed)", want: NewDate(2019, time.February, 6)},
+		{s: "March 15, 44 BC", want: NewDate(-43, time.March, 15)},
+		{s: "February 19", wantErr: true},
+		{s: "Febuary 2019", wantErr: true},
+		{s: "February 32, 2019", wantErr: true},
+		{s: "February", wantErr: true},
+		{s: `"2019-02-06"`, want: NewDate(2019, time.February, 6)},
+		{s: `'2019-02-06'`, want: NewDate(2019, time.February, 6)},
+		{s: ` " 2/6/2019 " `, want: NewDate(2019, time.February, 6)},
+		{s: `"February 6, 2019"`, want: NewDate(2019, time.February, 6)},
+		{s: `"2019-02-06'`, wantErr: true},
+		{s: `"2019-02-06`, wantErr: true},
+		{s: `""`, wantErr: true},
+		{s: "Feb 6th, 2019", want: NewDate(2019, time.February, 6)},
+		{s: "1st March 2020", want: NewDate(2020, time.March, 1)},
+		{s: "March 2nd, 2020", want: NewDate(2020, time.March, 2)},
+		{s: "23RD March 2020", want: NewDate(2020, time.March, 23)},
+		{s: "11th March 2020", want: NewDate(2020, time.March, 11)},
+		{s: "Feb 6st, 2019", wantErr: true},
+		{s: "12nd March 2020", wantErr: true},
+		{s: "Feb 6x, 2019", wantErr: true},
+		{s: "Sept 6, 2019", want: NewDate(2019, time.September, 6)},
+		{s: "Sep 6, 2019", want: NewDate(2019, time.September, 6)},
+		{s: "6 Sept 2019", want: NewDate(2019, time.September, 6)},
+		{s: "Oct 6, 2019", want: NewDate(2019, time.October, 6)},
+		{s: "Aug 6, 2019", want: NewDate(2019, time.August, 6)},
+		{s: "Feb2019", want: NewDate(2019, time.February, 1)},
+		{s: "2-6-2019", want: NewDate(2019, time.February, 6)},
+		{s: "02-06-2019 (Wed)", want: NewDate(2019, time.February, 6)},
+		{s: "-0753-04-21Z", want: NewDate(-753, time.April, 21)},
+		{s: "Feb '19", wantErr: true},
+		{s: "Feb-2019", want: NewDate(2019, time.February, 1)},
+		{s: "february2019", want: NewDate(2019, time.February, 1)},
+		{s: "Feb-2019 (Fri)", want: NewDate(2019, time.February, 1)},
+		{s: "Feb19", wantErr: true},
+		{s: "Feb-", wantErr: true},
+		{s: "Feb--2019", wantErr: true},
+		{s: "Feb", wantErr: true},
+		{s: "2Feb", wantErr: true},
+		{s: "2Feb2019", wantErr: true},
+		{s: "Feb th, 2019", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDateTolerant(test.s)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseDateTolerant(%q) = %v, %v; want %v, %s", test.s, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestParseDateTolerantPivot(t *testing.T) {
+	tests := []struct {
+		s       string
+		pivot   int
+		want    Date
+		wantErr bool
+	}{
+		{s: "Feb '19", pivot: 2069, want: NewDate(2019, time.February, 1)},
+		{s: "Feb '19", pivot: 2018, want: NewDate(1919, time.February, 1)},
+		{s: "Feb '99", pivot: 2069, want: NewDate(1999, time.February, 1)},
+		{s: "Feb'19", pivot: 2069, want: NewDate(2019, time.February, 1)},
+		{s: "6 Feb '19", pivot: 2069, want: NewDate(2019, time.February, 6)},
+		{s: "Feb 6th, '19", pivot: 2069, want: NewDate(2019, time.February, 6)},
+		{s: "Feb 2019", pivot: 2069, want: NewDate(2019, time.February, 1)},
+		{s: "2019-02-06", pivot: 2069, want: NewDate(2019, time.February, 6)},
+		{s: "Feb '19", pivot: 0, wantErr: true},
+		{s: "Feb '2019", pivot: 2069, wantErr: true},
+		{s: "Feb '9", pivot: 2069, wantErr: true},
+		{s: "Feb 19", pivot: 2069, wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDateTolerantPivot(test.s, test.pivot)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseDateTolerantPivot(%q, %d) = %v, %v; want %v, %s", test.s, test.pivot, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestLookupMonth(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   time.Month
+		wantOK bool
+	}{
+		{name: "January", want: time.January, wantOK: true},
+		{name: "january", want: time.January, wantOK: true},
+		{name: "JANUARY", want: time.January, wantOK: true},
+		{name: "jAn", want: time.January, wantOK: true},
+		{name: "Dec", want: time.December, wantOK: true},
+		{name: "September", want: time.September, wantOK: true},
+		{name: "Sept", want: time.September, wantOK: true},
+		{name: "SEPT", want: time.September, wantOK: true},
+		{name: "Sep", want: time.September, wantOK: true},
+		{name: "Janu", wantOK: false},
+		{name: "Octo", wantOK: false},
+		{name: "", wantOK: false},
+	}
+	for _, test := range tests {
+		got, ok := lookupMonth(test.name)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("lookupMonth(%q) = %v, %t; want %v, %t", test.name, got, ok, test.want, test.wantOK)
+		}
+	}
+}
+
+func BenchmarkParseDateTolerantMonthName(b *testing.B) {
+	inputs := []string{
+		"February 6, 2019",
+		"6 Sep 2019",
+		"DECEMBER 2019",
+		"Jan 31 2020",
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseDateTolerant(inputs[i%len(inputs)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseWeekOfYear parses a week in the form "2006-W01"
+// and returns the range of dates in that week,
+// where weeks begin on weekStart.
+// Week 1 is the first week that has at least four days in the year,
+// so with a weekStart of [time.Monday], weeks are numbered as in ISO 8601.
+func ParseWeekOfYear(s string, weekStart time.Weekday) (DateRange, error) {
+	yearPart, weekPart, ok := strings.Cut(strings.TrimSpace(s), "-W")
+	if !ok || len(weekPart) != 2 || !isDigits(weekPart) {
+		return DateRange{}, fmt.Errorf("parse week %q: unknown format", s)
+	}
+	year, err := strconv.Atoi(yearPart)
+	if err != nil {
+		return DateRange{}, fmt.Errorf("parse week %q: year: %v", s, err)
+	}
+	week, _ := strconv.Atoi(weekPart)
+	if !(1 <= week && week <= weeksInYear(year, weekStart)) {
+		return DateRange{}, fmt.Errorf("parse week %q: invalid week %d", s, week)
+	}
+	start := weekOneStart(year, weekStart).absDays() + 7*(week-1)
+	return DateRange{
+		Start: dateFromAbsDays(start),
+		End:   dateFromAbsDays(start + 6),
+	}, nil
+}
+
+// ISOWeek returns the ISO 8601 year and week number in which d occurs.
+// Week ranges from 1 to 53. Jan 01 to Jan 03 of year n might belong to
+// week 52 or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1
+// of year n+1.
+func (d Date) ISOWeek() (year, week int) {
+	year = d.Year()
+	days := d.absDays()
+	if next := weekOneStart(year+1, time.Monday).absDays(); days >= next {
+		return year + 1, (days-next)/7 + 1
+	}
+	start := weekOneStart(year, time.Monday).absDays()
+	if days < start {
+		year--
+		start = weekOneStart(year, time.Monday).absDays()
+	}
+	return year, (days-start)/7 + 1
+}
+
+// WeekBounds returns the seven-day range of dates
+// in the week containing d, where weeks begin on weekStart.
+func (d Date) WeekBounds(weekStart time.Weekday) DateRange {
+	offset := int(d.Weekday()-weekStart+7) % 7
+	start := d.absDays() - offset
+	return DateRange{
+		Start: dateFromAbsDays(start),
+		End:   dateFromAbsDays(start + 6),
+	}
+}
+
+// weekOneStart returns the first day of week 1 of the given year
+// for weeks beginning on weekStart.
+// Week 1 is the first week that has at least four days in the year.
+func weekOneStart(year int, weekStart time.Weekday) Date {
+	jan1 := NewDate(year, time.January, 1)
+	offset := int(jan1.Weekday()-weekStart+7) % 7
+	start := jan1.absDays() - offset
+	if 7-offset < 4 {
+		start += 7
+	}
+	return dateFromAbsDays(start)
+}
+
+// weeksInYear returns the number of weeks in the given year
+// for weeks beginning on weekStart.
+func weeksInYear(year int, weekStart time.Weekday) int {
+	return (weekOneStart(year+1, weekStart).absDays() - weekOneStart(year, weekStart).absDays()) / 7
+}
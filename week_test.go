@@ -0,0 +1,170 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWeekOfYear(t *testing.T) {
+	tests := []struct {
+		s         string
+		weekStart time.Weekday
+		want      DateRange
+		wantErr   bool
+	}{
+		{
+			s:         "2019-W06",
+			weekStart: time.Monday,
+			want:      DateRange{Start: NewDate(2019, time.February, 4), End: NewDate(2019, time.February, 10)},
+		},
+		{
+			s:         "2019-W06",
+			weekStart: time.Sunday,
+			want:      DateRange{Start: NewDate(2019, time.February, 3), End: NewDate(2019, time.February, 9)},
+		},
+		{
+			s:         "2019-W01",
+			weekStart: time.Monday,
+			want:      DateRange{Start: NewDate(2018, time.December, 31), End: NewDate(2019, time.January, 6)},
+		},
+		{
+			s:         "2019-W01",
+			weekStart: time.Sunday,
+			want:      DateRange{Start: NewDate(2018, time.December, 30), End: NewDate(2019, time.January, 5)},
+		},
+		{
+			// January 1, 2022 is a Saturday, so the Sunday-start week containing it
+			// has only one day in 2022.
+			s:         "2022-W01",
+			weekStart: time.Sunday,
+			want:      DateRange{Start: NewDate(2022, time.January, 2), End: NewDate(2022, time.January, 8)},
+		},
+		{
+			s:         "2020-W53",
+			weekStart: time.Monday,
+			want:      DateRange{Start: NewDate(2020, time.December, 28), End: NewDate(2021, time.January, 3)},
+		},
+		{s: "2019-W53", weekStart: time.Monday, wantErr: true},
+		{s: "2019-W00", weekStart: time.Monday, wantErr: true},
+		{s: "2019-W6", weekStart: time.Monday, wantErr: true},
+		{s: "2019-06", weekStart: time.Monday, wantErr: true},
+		{s: "W06", weekStart: time.Monday, wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseWeekOfYear(test.s, test.weekStart)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseWeekOfYear(%q, %v) = %v, %v; want %v, %s", test.s, test.weekStart, got, err, test.want, wantErr)
+		}
+	}
+}
+
+func TestWeekOneStartMatchesISO(t *testing.T) {
+	for year := 1990; year <= 2040; year++ {
+		start := weekOneStart(year, time.Monday)
+		tm := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		if isoYear, isoWeek := tm.ISOWeek(); isoYear != year || isoWeek != 1 {
+			t.Errorf("weekOneStart(%d, time.Monday) = %v, which is ISO week %d-W%02d", year, start, isoYear, isoWeek)
+		}
+		before := tm.AddDate(0, 0, -1)
+		if isoYear, _ := before.ISOWeek(); isoYear == year {
+			t.Errorf("weekOneStart(%d, time.Monday) = %v, but the day before is also in ISO year %d", year, start, year)
+		}
+	}
+}
+
+func TestWeekBounds(t *testing.T) {
+	tests := []struct {
+		d         Date
+		weekStart time.Weekday
+		want      DateRange
+	}{
+		{
+			// Friday, March 1, 2019
+			d:         NewDate(2019, time.March, 1),
+			weekStart: time.Monday,
+			want:      DateRange{NewDate(2019, time.February, 25), NewDate(2019, time.March, 3)},
+		},
+		{
+			d:         NewDate(2019, time.March, 1),
+			weekStart: time.Sunday,
+			want:      DateRange{NewDate(2019, time.February, 24), NewDate(2019, time.March, 2)},
+		},
+		{
+			// Sunday, March 3, 2019
+			d:         NewDate(2019, time.March, 3),
+			weekStart: time.Monday,
+			want:      DateRange{NewDate(2019, time.February, 25), NewDate(2019, time.March, 3)},
+		},
+		{
+			d:         NewDate(2019, time.March, 3),
+			weekStart: time.Sunday,
+			want:      DateRange{NewDate(2019, time.March, 3), NewDate(2019, time.March, 9)},
+		},
+		{
+			// Monday, December 30, 2019
+			d:         NewDate(2019, time.December, 30),
+			weekStart: time.Monday,
+			want:      DateRange{NewDate(2019, time.December, 30), NewDate(2020, time.January, 5)},
+		},
+		{
+			d:         NewDate(2019, time.December, 30),
+			weekStart: time.Saturday,
+			want:      DateRange{NewDate(2019, time.December, 28), NewDate(2020, time.January, 3)},
+		},
+	}
+	for _, test := range tests {
+		if got := test.d.WeekBounds(test.weekStart); got != test.want {
+			t.Errorf("%v.WeekBounds(%v) = %v; want %v", test.d, test.weekStart, got, test.want)
+		}
+	}
+}
+
+func TestISOWeek(t *testing.T) {
+	tests := []struct {
+		d        Date
+		wantYear int
+		wantWeek int
+	}{
+		{d: NewDate(2021, time.January, 1), wantYear: 2020, wantWeek: 53},
+		{d: NewDate(2020, time.December, 31), wantYear: 2020, wantWeek: 53},
+		{d: NewDate(2021, time.January, 4), wantYear: 2021, wantWeek: 1},
+		{d: NewDate(2019, time.December, 30), wantYear: 2020, wantWeek: 1},
+		{d: NewDate(2019, time.February, 6), wantYear: 2019, wantWeek: 6},
+		{d: NewDate(2016, time.January, 3), wantYear: 2015, wantWeek: 53},
+	}
+	for _, test := range tests {
+		year, week := test.d.ISOWeek()
+		if year != test.wantYear || week != test.wantWeek {
+			t.Errorf("%v.ISOWeek() = %d, %d; want %d, %d", test.d, year, week, test.wantYear, test.wantWeek)
+		}
+	}
+
+	// Agree with the time package.
+	for d := NewDate(1999, time.December, 1); d.Year() < 2030; d = d.Add(0, 0, 1) {
+		year, week := d.ISOWeek()
+		wantYear, wantWeek := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC).ISOWeek()
+		if year != wantYear || week != wantWeek {
+			t.Errorf("%v.ISOWeek() = %d, %d; want %d, %d", d, year, week, wantYear, wantWeek)
+		}
+	}
+}
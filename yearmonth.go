@@ -0,0 +1,78 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A YearMonth is a month of a particular year.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// String returns the month in ISO 8601 format, like "2006-01".
+func (ym YearMonth) String() string {
+	return fmt.Sprintf("%04d-%02d", ym.Year, int(ym.Month))
+}
+
+// addMonths returns the month n months after ym.
+func (ym YearMonth) addMonths(n int) YearMonth {
+	m := ym.Year*12 + int(ym.Month-time.January) + n
+	return YearMonth{Year: floorDiv(m, 12), Month: time.Month(floorMod(m, 12)) + time.January}
+}
+
+// ParseRelativeMonth parses an English description of a month
+// relative to the month of base.
+// It accepts "this month", "last month", "next month",
+// "N months ago", and "in N months", ignoring case.
+func ParseRelativeMonth(s string, base Date) (YearMonth, error) {
+	ym := YearMonth{Year: base.Year(), Month: base.Month()}
+	words := strings.Fields(strings.ToLower(s))
+	switch {
+	case len(words) == 2 && words[1] == "month":
+		switch words[0] {
+		case "this":
+			return ym, nil
+		case "last":
+			return ym.addMonths(-1), nil
+		case "next":
+			return ym.addMonths(1), nil
+		}
+	case len(words) == 3 && isMonthsWord(words[1]) && words[2] == "ago":
+		n, err := strconv.Atoi(words[0])
+		if err != nil || n < 0 {
+			return YearMonth{}, fmt.Errorf("parse relative month %q: invalid count %q", s, words[0])
+		}
+		return ym.addMonths(-n), nil
+	case len(words) == 3 && words[0] == "in" && isMonthsWord(words[2]):
+		n, err := strconv.Atoi(words[1])
+		if err != nil || n < 0 {
+			return YearMonth{}, fmt.Errorf("parse relative month %q: invalid count %q", s, words[1])
+		}
+		return ym.addMonths(n), nil
+	}
+	return YearMonth{}, fmt.Errorf("parse relative month %q: unknown format", s)
+}
+
+func isMonthsWord(s string) bool {
+	return s == "month" || s == "months"
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Ross Light
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//		 https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gregorian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRelativeMonth(t *testing.T) {
+	tests := []struct {
+		s       string
+		base    Date
+		want    YearMonth
+		wantErr bool
+	}{
+		{s: "this month", base: NewDate(2019, time.January, 15), want: YearMonth{2019, time.January}},
+		{s: "last month", base: NewDate(2019, time.January, 15), want: YearMonth{2018, time.December}},
+		{s: "next month", base: NewDate(2019, time.January, 15), want: YearMonth{2019, time.February}},
+		{s: "next month", base: NewDate(2019, time.December, 31), want: YearMonth{2020, time.January}},
+		{s: "Last  Month", base: NewDate(2019, time.March, 31), want: YearMonth{2019, time.February}},
+		{s: "3 months ago", base: NewDate(2019, time.February, 6), want: YearMonth{2018, time.November}},
+		{s: "1 month ago", base: NewDate(2019, time.February, 6), want: YearMonth{2019, time.January}},
+		{s: "0 months ago", base: NewDate(2019, time.February, 6), want: YearMonth{2019, time.February}},
+		{s: "24 months ago", base: NewDate(2019, time.February, 6), want: YearMonth{2017, time.February}},
+		{s: "in 11 months", base: NewDate(2019, time.February, 6), want: YearMonth{2020, time.January}},
+		{s: "-3 months ago", base: NewDate(2019, time.February, 6), wantErr: true},
+		{s: "three months ago", base: NewDate(2019, time.February, 6), wantErr: true},
+		{s: "last year", base: NewDate(2019, time.February, 6), wantErr: true},
+		{s: "", base: NewDate(2019, time.February, 6), wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseRelativeMonth(test.s, test.base)
+		if got != test.want || (err != nil) != test.wantErr {
+			wantErr := "<nil>"
+			if test.wantErr {
+				wantErr = "<non-nil>"
+			}
+			t.Errorf("ParseRelativeMonth(%q, %v) = %v, %v; want %v, %s", test.s, test.base, got, err, test.want, wantErr)
+		}
+	}
+}